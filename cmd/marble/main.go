@@ -31,6 +31,7 @@ import (
 	neosimulation "github.com/R3E-Network/neo-miniapps-platform/services/simulation/marble"
 	txproxy "github.com/R3E-Network/neo-miniapps-platform/services/txproxy/marble"
 	neovrf "github.com/R3E-Network/neo-miniapps-platform/services/vrf/marble"
+	neovrfsupabase "github.com/R3E-Network/neo-miniapps-platform/services/vrf/supabase"
 )
 
 func main() {
@@ -115,12 +116,24 @@ func main() {
 				}
 			}
 
+			oracleMaxCacheTTL := time.Duration(0)
+			if raw := strings.TrimSpace(os.Getenv("ORACLE_CACHE_MAX_TTL")); raw != "" {
+				if parsed, parseErr := time.ParseDuration(raw); parseErr != nil {
+					log.Printf("Warning: invalid ORACLE_CACHE_MAX_TTL %q: %v", raw, parseErr)
+				} else if parsed <= 0 {
+					oracleMaxCacheTTL = -1 // disable response caching
+				} else {
+					oracleMaxCacheTTL = parsed
+				}
+			}
+
 			return neooracle.New(neooracle.Config{
 				Marble:         deps.Marble,
 				SecretProvider: commonservice.NewServiceSecretsProvider(deps.Marble, deps.DB, neooracle.ServiceID),
 				Timeout:        oracleTimeout,
 				MaxBodyBytes:   oracleMaxBodyBytes,
 				URLAllowlist:   oracleAllowlist,
+				MaxCacheTTL:    oracleMaxCacheTTL,
 			})
 		},
 		"neorequests": func(deps *commonservice.SharedDeps) (commonservice.Runner, error) {
@@ -152,8 +165,9 @@ func main() {
 		},
 		"neovrf": func(deps *commonservice.SharedDeps) (commonservice.Runner, error) {
 			return neovrf.New(neovrf.Config{
-				Marble: deps.Marble,
-				DB:     deps.DB,
+				Marble:    deps.Marble,
+				DB:        deps.DB,
+				ProofRepo: neovrfsupabase.NewRepository(deps.DB),
 			})
 		},
 		"neogasbank": func(deps *commonservice.SharedDeps) (commonservice.Runner, error) {
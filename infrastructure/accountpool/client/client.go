@@ -233,6 +233,18 @@ func (c *Client) SignTransaction(ctx context.Context, accountID string, txHash [
 	return &out, nil
 }
 
+// RotateKey rotates the key of an account locked by this service.
+func (c *Client) RotateKey(ctx context.Context, accountID string) (*RotateKeyResponse, error) {
+	var out RotateKeyResponse
+	if err := c.doJSON(ctx, http.MethodPost, "/rotate-key", RotateKeyInput{
+		ServiceID: c.serviceID,
+		AccountID: accountID,
+	}, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 // BatchSign signs multiple transaction hashes.
 func (c *Client) BatchSign(ctx context.Context, requests []SignRequest) (*BatchSignResponse, error) {
 	var out BatchSignResponse
@@ -22,6 +22,8 @@ type (
 	ReleaseAccountsResponse  = neoaccountstypes.ReleaseAccountsResponse
 	SignTransactionInput     = neoaccountstypes.SignTransactionInput
 	SignTransactionResponse  = neoaccountstypes.SignTransactionResponse
+	RotateKeyInput           = neoaccountstypes.RotateKeyInput
+	RotateKeyResponse        = neoaccountstypes.RotateKeyResponse
 	BatchSignInput           = neoaccountstypes.BatchSignInput
 	SignRequest              = neoaccountstypes.SignRequest
 	BatchSignResponse        = neoaccountstypes.BatchSignResponse
@@ -24,6 +24,7 @@ func (s *Service) registerRoutes() {
 	router.Handle("/release", middleware.RequireServiceAuth(http.HandlerFunc(s.handleReleaseAccounts))).Methods("POST")
 	router.Handle("/sign", middleware.RequireServiceAuth(http.HandlerFunc(s.handleSignTransaction))).Methods("POST")
 	router.Handle("/batch-sign", middleware.RequireServiceAuth(http.HandlerFunc(s.handleBatchSign))).Methods("POST")
+	router.Handle("/rotate-key", middleware.RequireServiceAuth(http.HandlerFunc(s.handleRotateKey))).Methods("POST")
 	router.Handle("/balance", middleware.RequireServiceAuth(http.HandlerFunc(s.handleUpdateBalance))).Methods("POST")
 	router.Handle("/transfer", middleware.RequireServiceAuth(http.HandlerFunc(s.handleTransfer))).Methods("POST")
 	router.Handle("/transfer-with-data", middleware.RequireServiceAuth(http.HandlerFunc(s.handleTransferWithData))).Methods("POST")
@@ -2,11 +2,13 @@
 package neoaccounts
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
 	"strconv"
 	"strings"
 
+	neoaccountssupabase "github.com/R3E-Network/neo-miniapps-platform/infrastructure/accountpool/supabase"
 	"github.com/R3E-Network/neo-miniapps-platform/infrastructure/httputil"
 )
 
@@ -136,7 +138,7 @@ func (s *Service) handleSignTransaction(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
-	resp, err := s.SignTransaction(r.Context(), input.ServiceID, input.AccountID, input.TxHash)
+	resp, err := s.signTransactionWithKey(r.Context(), input.ServiceID, input.AccountID, input.TxHash, input.KeyVersion)
 	if err != nil {
 		s.Logger().WithContext(r.Context()).WithError(err).Error("failed to sign transaction")
 		httputil.InternalError(w, "failed to sign transaction")
@@ -146,6 +148,42 @@ func (s *Service) handleSignTransaction(w http.ResponseWriter, r *http.Request)
 	httputil.WriteJSON(w, http.StatusOK, resp)
 }
 
+// handleRotateKey rotates the key of an account locked by the calling service.
+func (s *Service) handleRotateKey(w http.ResponseWriter, r *http.Request) {
+	var input RotateKeyInput
+	if !httputil.DecodeJSON(w, r, &input) {
+		return
+	}
+
+	serviceID, ok := resolveServiceID(w, r, input.ServiceID)
+	if !ok {
+		return
+	}
+	input.ServiceID = serviceID
+
+	if input.AccountID == "" {
+		httputil.BadRequest(w, "account_id required")
+		return
+	}
+
+	resp, err := s.RotateAccountKey(r.Context(), input.ServiceID, input.AccountID)
+	if err != nil {
+		switch {
+		case errors.Is(err, ErrAccountNotLocked):
+			httputil.WriteError(w, http.StatusForbidden, err.Error())
+		case errors.Is(err, ErrRotationInGracePeriod), errors.Is(err, ErrRotationConflict),
+			errors.Is(err, ErrAccountHasBalance):
+			httputil.WriteError(w, http.StatusConflict, err.Error())
+		default:
+			s.Logger().WithContext(r.Context()).WithError(err).Error("failed to rotate account key")
+			httputil.InternalError(w, "failed to rotate account key")
+		}
+		return
+	}
+
+	httputil.WriteJSON(w, http.StatusOK, resp)
+}
+
 // handleBatchSign signs multiple transactions.
 func (s *Service) handleBatchSign(w http.ResponseWriter, r *http.Request) {
 	var input BatchSignInput
@@ -476,20 +514,25 @@ func (s *Service) handleListLowBalanceAccounts(w http.ResponseWriter, r *http.Re
 		}
 	}
 
-	limitStr := r.URL.Query().Get("limit")
-	limit := 10
-	if limitStr != "" {
-		if parsed, err := strconv.Atoi(limitStr); err == nil && parsed > 0 {
-			limit = parsed
-		}
+	page, err := httputil.ParsePageParams(r, 10, neoaccountssupabase.MaxLowBalancePageSize)
+	if err != nil {
+		httputil.BadRequest(w, err.Error())
+		return
 	}
 
-	accounts, err := s.ListLowBalanceAccounts(r.Context(), tokenType, maxBalance, limit)
+	lowPage := neoaccountssupabase.LowBalancePage{Limit: page.Limit, Offset: page.Offset}
+	if page.Cursor != nil {
+		lowPage.AfterID = page.Cursor.ID
+	}
+	accounts, err := s.ListLowBalanceAccounts(r.Context(), tokenType, maxBalance, lowPage)
 	if err != nil {
 		s.Logger().WithContext(r.Context()).WithError(err).Error("failed to list low balance accounts")
 		httputil.InternalError(w, "failed to list low balance accounts")
 		return
 	}
+	if len(accounts) == page.Limit {
+		w.Header().Set(httputil.NextCursorHeader, httputil.EncodeNextCursor("", accounts[len(accounts)-1].ID))
+	}
 
 	httputil.WriteJSON(w, http.StatusOK, ListAccountsResponse{
 		Accounts: accounts,
@@ -0,0 +1,235 @@
+// Package neoaccounts provides account key rotation for the neoaccounts service.
+package neoaccounts
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/nspcc-dev/neo-go/pkg/crypto/keys"
+
+	neoaccountssupabase "github.com/R3E-Network/neo-miniapps-platform/infrastructure/accountpool/supabase"
+	"github.com/R3E-Network/neo-miniapps-platform/infrastructure/crypto"
+)
+
+// KeyRotationGracePeriod is how long an account's previous key can still sign
+// after a rotation.
+const KeyRotationGracePeriod = 24 * time.Hour
+
+const keyRotationDomain = "neoaccounts-key-rotation-v1"
+
+var (
+	// ErrAccountNotLocked is returned when the account is not locked by the caller.
+	ErrAccountNotLocked = errors.New("account not locked by service")
+	// ErrRotationInGracePeriod is returned when the previous rotation's grace
+	// period has not ended yet.
+	ErrRotationInGracePeriod = errors.New("previous key still in grace period")
+	// ErrRotationConflict is returned when the account changed during rotation.
+	ErrRotationConflict = errors.New("account changed during key rotation")
+	// ErrAccountHasBalance is returned when rotating an account whose address
+	// still holds funds; they must be moved before the address changes.
+	ErrAccountHasBalance = errors.New("account still holds a balance")
+	// ErrPreviousKeyUnavailable is returned when signing with a previous key
+	// that is unknown or past its grace period.
+	ErrPreviousKeyUnavailable = errors.New("requested key version not available")
+)
+
+// KeyRotationDigest is the hash signed by the old key to prove a rotation:
+// sha256(domain || accountID || oldPubKey || newPubKey || newVersion || rotatedAt).
+// Integers are 8-byte big-endian; rotatedAt is in Unix seconds.
+func KeyRotationDigest(accountID string, oldPubKey, newPubKey []byte, newVersion int, rotatedAt time.Time) []byte {
+	var buf [8]byte
+	h := sha256.New()
+	h.Write([]byte(keyRotationDomain))
+	h.Write([]byte(accountID))
+	h.Write(oldPubKey)
+	h.Write(newPubKey)
+	binary.BigEndian.PutUint64(buf[:], uint64(newVersion))
+	h.Write(buf[:])
+	binary.BigEndian.PutUint64(buf[:], uint64(rotatedAt.Unix()))
+	h.Write(buf[:])
+	return h.Sum(nil)
+}
+
+// RotateAccountKey replaces the key of an account locked by serviceID with a
+// newly derived one. The account's address changes, so rotation is refused
+// while any tracked balance is non-zero. The old key signs a rotation proof and
+// stays usable for KeyRotationGracePeriod (via SignTransactionInput.KeyVersion)
+// so in-flight transactions can complete. Every replaced key is kept in the
+// account's rotation history.
+func (s *Service) RotateAccountKey(ctx context.Context, serviceID, accountID string) (*RotateKeyResponse, error) {
+	if s.repo == nil {
+		return nil, fmt.Errorf("repository not configured")
+	}
+
+	acc, err := s.repo.GetByID(ctx, accountID)
+	if err != nil {
+		return nil, fmt.Errorf("account not found: %w", err)
+	}
+	if acc.LockedBy != serviceID {
+		return nil, ErrAccountNotLocked
+	}
+
+	history, err := s.repo.ListKeyRotations(ctx, accountID)
+	if err != nil {
+		return nil, fmt.Errorf("load key history: %w", err)
+	}
+
+	now := time.Now().UTC()
+	if last := appliedRotation(acc, history); last != nil && now.Before(last.GraceUntil) {
+		return nil, ErrRotationInGracePeriod
+	}
+
+	balances, err := s.repo.GetBalances(ctx, accountID)
+	if err != nil {
+		return nil, fmt.Errorf("load balances: %w", err)
+	}
+	for i := range balances {
+		if balances[i].Amount != 0 {
+			return nil, fmt.Errorf("%w: %s %d", ErrAccountHasBalance, balances[i].TokenType, balances[i].Amount)
+		}
+	}
+
+	oldPriv, err := s.accountPrivateKey(acc)
+	if err != nil {
+		return nil, fmt.Errorf("load current key: %w", err)
+	}
+
+	// Versions are never reused, even those of rotations that did not apply.
+	newVersion := acc.RotatedKeyVersion + 1
+	for i := range history {
+		if history[i].NewKeyVersion >= newVersion {
+			newVersion = history[i].NewKeyVersion + 1
+		}
+	}
+	newKey, err := s.deriveAccountKeyVersion(accountID, newVersion)
+	if err != nil {
+		return nil, fmt.Errorf("derive new key: %w", err)
+	}
+	newPriv, err := keys.NewPrivateKeyFromBytes(newKey)
+	crypto.ZeroBytes(newKey)
+	if err != nil {
+		return nil, fmt.Errorf("create neo private key: %w", err)
+	}
+
+	oldPub := crypto.PublicKeyToBytes(&oldPriv.PublicKey)
+	newPub := newPriv.PublicKey().Bytes()
+	rotatedAt := now.Truncate(time.Second)
+
+	proof, err := signHash(oldPriv, KeyRotationDigest(accountID, oldPub, newPub, newVersion, rotatedAt))
+	if err != nil {
+		return nil, fmt.Errorf("sign rotation proof: %w", err)
+	}
+
+	rotation := &neoaccountssupabase.KeyRotation{
+		ID:              uuid.New().String(),
+		AccountID:       accountID,
+		ServiceID:       serviceID,
+		OldAddress:      acc.Address,
+		NewAddress:      newPriv.Address(),
+		OldKeyVersion:   acc.RotatedKeyVersion,
+		NewKeyVersion:   newVersion,
+		OldPublicKey:    hex.EncodeToString(oldPub),
+		NewPublicKey:    hex.EncodeToString(newPub),
+		OldEncryptedWIF: acc.EncryptedWIF,
+		Proof:           hex.EncodeToString(proof),
+		RotatedAt:       rotatedAt,
+		GraceUntil:      rotatedAt.Add(KeyRotationGracePeriod),
+	}
+
+	// The history record goes first: it holds the old key, and a rotation
+	// must never apply without it.
+	if err := s.repo.CreateKeyRotation(ctx, rotation); err != nil {
+		return nil, fmt.Errorf("record key rotation: %w", err)
+	}
+
+	rotated, err := s.repo.TryRotateKey(ctx, acc, serviceID, rotation)
+	if err == nil && !rotated {
+		err = ErrRotationConflict
+	}
+	if err != nil {
+		if delErr := s.repo.DeleteKeyRotation(ctx, rotation.ID); delErr != nil {
+			s.Logger().WithContext(ctx).WithError(delErr).WithFields(map[string]interface{}{
+				"account_id":  accountID,
+				"rotation_id": rotation.ID,
+			}).Warn("failed to remove unapplied key rotation record")
+		}
+		if errors.Is(err, ErrRotationConflict) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("rotate key: %w", err)
+	}
+
+	// Balances were zero; reset the rows so they are dated to the new address.
+	for i := range balances {
+		bal := &balances[i]
+		if err := s.repo.UpsertBalance(ctx, accountID, bal.TokenType, bal.ScriptHash, 0, bal.Decimals); err != nil {
+			s.Logger().WithContext(ctx).WithError(err).WithFields(map[string]interface{}{
+				"account_id": accountID,
+				"token_type": bal.TokenType,
+			}).Warn("failed to reset balance after key rotation")
+		}
+	}
+
+	s.Logger().WithContext(ctx).WithFields(map[string]interface{}{
+		"account_id":  accountID,
+		"service_id":  serviceID,
+		"key_version": newVersion,
+		"old_address": rotation.OldAddress,
+		"new_address": rotation.NewAddress,
+	}).Info("account key rotated")
+
+	return &RotateKeyResponse{
+		AccountID:     accountID,
+		OldAddress:    rotation.OldAddress,
+		NewAddress:    rotation.NewAddress,
+		OldKeyVersion: rotation.OldKeyVersion,
+		NewKeyVersion: newVersion,
+		OldPublicKey:  oldPub,
+		NewPublicKey:  newPub,
+		Proof:         proof,
+		RotatedAt:     rotatedAt,
+		GraceUntil:    rotation.GraceUntil,
+	}, nil
+}
+
+// appliedRotation returns the history record of the rotation that produced
+// the account's current key, or nil if the key was never rotated.
+func appliedRotation(acc *neoaccountssupabase.Account, history []neoaccountssupabase.KeyRotation) *neoaccountssupabase.KeyRotation {
+	if acc.RotatedKeyVersion == 0 {
+		return nil
+	}
+	for i := range history {
+		if history[i].NewKeyVersion == acc.RotatedKeyVersion && history[i].NewAddress == acc.Address {
+			return &history[i]
+		}
+	}
+	return nil
+}
+
+// accountPrivateKeyVersion returns the account's current key, or the key it
+// replaced while the last rotation is within its grace period.
+func (s *Service) accountPrivateKeyVersion(ctx context.Context, acc *neoaccountssupabase.Account, version int) (*ecdsa.PrivateKey, error) {
+	if version == acc.RotatedKeyVersion {
+		return s.accountPrivateKey(acc)
+	}
+
+	history, err := s.repo.ListKeyRotations(ctx, acc.ID)
+	if err != nil {
+		return nil, fmt.Errorf("load key history: %w", err)
+	}
+	last := appliedRotation(acc, history)
+	if last == nil || version != last.OldKeyVersion || !time.Now().Before(last.GraceUntil) {
+		return nil, ErrPreviousKeyUnavailable
+	}
+	if last.OldEncryptedWIF != "" {
+		return s.decryptWIFToPrivateKey(last.OldEncryptedWIF)
+	}
+	return s.derivePrivateKey(acc.ID, version)
+}
@@ -0,0 +1,205 @@
+package neoaccounts
+
+import (
+	"bytes"
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/nspcc-dev/neo-go/pkg/crypto/keys"
+
+	neoaccountssupabase "github.com/R3E-Network/neo-miniapps-platform/infrastructure/accountpool/supabase"
+	"github.com/R3E-Network/neo-miniapps-platform/infrastructure/crypto"
+)
+
+func TestRotateAccountKey(t *testing.T) {
+	svc, mockRepo := newTestServiceWithMock(t)
+	ctx := context.Background()
+
+	oldPriv, err := svc.derivePrivateKey("acc-1", 0)
+	if err != nil {
+		t.Fatalf("derivePrivateKey: %v", err)
+	}
+	mockRepo.accounts["acc-1"] = &neoaccountssupabase.Account{ID: "acc-1", Address: "NOldAddr", LockedBy: "neocompute"}
+
+	resp, err := svc.RotateAccountKey(ctx, "neocompute", "acc-1")
+	if err != nil {
+		t.Fatalf("RotateAccountKey() error = %v", err)
+	}
+	if resp.NewKeyVersion != 1 || resp.OldAddress != "NOldAddr" || resp.NewAddress == resp.OldAddress {
+		t.Fatalf("unexpected rotation: %+v", resp)
+	}
+	if !bytes.Equal(resp.OldPublicKey, crypto.PublicKeyToBytes(&oldPriv.PublicKey)) {
+		t.Fatal("old public key does not match the account's previous key")
+	}
+	digest := KeyRotationDigest("acc-1", resp.OldPublicKey, resp.NewPublicKey, resp.NewKeyVersion, resp.RotatedAt)
+	if !verifySignature(&oldPriv.PublicKey, digest, resp.Proof) {
+		t.Fatal("rotation proof should verify with the old key")
+	}
+	if len(mockRepo.rotations) != 1 || mockRepo.rotations[0].NewAddress != resp.NewAddress {
+		t.Fatalf("rotations = %+v, want one audit record", mockRepo.rotations)
+	}
+
+	txHash := crypto.Hash256([]byte("in-flight"))
+	current, err := svc.SignTransaction(ctx, "neocompute", "acc-1", txHash)
+	if err != nil {
+		t.Fatalf("SignTransaction() error = %v", err)
+	}
+	if !bytes.Equal(current.PublicKey, resp.NewPublicKey) {
+		t.Fatal("SignTransaction should use the new key")
+	}
+
+	previous := 0
+	old, err := svc.signTransactionWithKey(ctx, "neocompute", "acc-1", txHash, &previous)
+	if err != nil {
+		t.Fatalf("sign with previous key error = %v", err)
+	}
+	if !bytes.Equal(old.PublicKey, resp.OldPublicKey) {
+		t.Fatal("previous key should remain usable during the grace period")
+	}
+
+	if _, err := svc.RotateAccountKey(ctx, "neocompute", "acc-1"); !errors.Is(err, ErrRotationInGracePeriod) {
+		t.Fatalf("second rotation err = %v, want ErrRotationInGracePeriod", err)
+	}
+
+	mockRepo.rotations[0].GraceUntil = time.Now().Add(-time.Minute)
+	if _, err := svc.signTransactionWithKey(ctx, "neocompute", "acc-1", txHash, &previous); !errors.Is(err, ErrPreviousKeyUnavailable) {
+		t.Fatalf("sign after grace err = %v, want ErrPreviousKeyUnavailable", err)
+	}
+
+	second, err := svc.RotateAccountKey(ctx, "neocompute", "acc-1")
+	if err != nil {
+		t.Fatalf("second rotation after grace error = %v", err)
+	}
+	if second.OldKeyVersion != 1 || second.NewKeyVersion != 2 {
+		t.Fatalf("second rotation versions = %d -> %d, want 1 -> 2", second.OldKeyVersion, second.NewKeyVersion)
+	}
+	if len(mockRepo.rotations) != 2 {
+		t.Fatalf("rotations = %d, want full key history of 2", len(mockRepo.rotations))
+	}
+}
+
+func TestRotateAccountKeyKeepsPreGeneratedWIF(t *testing.T) {
+	svc, mockRepo := newTestServiceWithMock(t)
+	ctx := context.Background()
+	svc.encryptionKey = bytes.Repeat([]byte{0x42}, 32)
+
+	original, err := keys.NewPrivateKey()
+	if err != nil {
+		t.Fatalf("NewPrivateKey: %v", err)
+	}
+	encryptedWIF := encryptTestWIF(t, svc.encryptionKey, original.WIF())
+	mockRepo.accounts["acc-1"] = &neoaccountssupabase.Account{
+		ID:           "acc-1",
+		Address:      original.Address(),
+		EncryptedWIF: encryptedWIF,
+		LockedBy:     "neocompute",
+	}
+
+	if _, err := svc.RotateAccountKey(ctx, "neocompute", "acc-1"); err != nil {
+		t.Fatalf("first rotation error = %v", err)
+	}
+	mockRepo.rotations[0].GraceUntil = time.Now().Add(-time.Minute)
+	if _, err := svc.RotateAccountKey(ctx, "neocompute", "acc-1"); err != nil {
+		t.Fatalf("second rotation error = %v", err)
+	}
+
+	if got := mockRepo.rotations[0].OldEncryptedWIF; got != encryptedWIF {
+		t.Fatal("the original WIF should stay in the key history after later rotations")
+	}
+	priv, err := svc.decryptWIFToPrivateKey(mockRepo.rotations[0].OldEncryptedWIF)
+	if err != nil {
+		t.Fatalf("decrypt history WIF: %v", err)
+	}
+	if !bytes.Equal(crypto.PublicKeyToBytes(&priv.PublicKey), original.PublicKey().Bytes()) {
+		t.Fatal("history WIF does not match the original key")
+	}
+}
+
+func TestRotateAccountKeyRefusesFundedAccount(t *testing.T) {
+	svc, mockRepo := newTestServiceWithMock(t)
+	ctx := context.Background()
+	mockRepo.accounts["acc-1"] = &neoaccountssupabase.Account{ID: "acc-1", Address: "NAddr", LockedBy: "neocompute"}
+	if err := mockRepo.UpsertBalance(ctx, "acc-1", TokenTypeGAS, neoaccountssupabase.GASScriptHash, 100, 8); err != nil {
+		t.Fatalf("UpsertBalance: %v", err)
+	}
+
+	if _, err := svc.RotateAccountKey(ctx, "neocompute", "acc-1"); !errors.Is(err, ErrAccountHasBalance) {
+		t.Fatalf("err = %v, want ErrAccountHasBalance", err)
+	}
+	if mockRepo.accounts["acc-1"].Address != "NAddr" || len(mockRepo.rotations) != 0 {
+		t.Fatal("a funded account must not be rotated")
+	}
+}
+
+func TestRotateAccountKeyConflictRemovesRecord(t *testing.T) {
+	svc, mockRepo := newTestServiceWithMock(t)
+	svc.repo = &conflictingRotationRepo{mockNeoAccountsRepo: mockRepo}
+	mockRepo.accounts["acc-1"] = &neoaccountssupabase.Account{ID: "acc-1", Address: "NAddr", LockedBy: "neocompute"}
+
+	if _, err := svc.RotateAccountKey(context.Background(), "neocompute", "acc-1"); !errors.Is(err, ErrRotationConflict) {
+		t.Fatalf("err = %v, want ErrRotationConflict", err)
+	}
+	if len(mockRepo.rotations) != 0 {
+		t.Fatalf("rotations = %+v, want the unapplied record removed", mockRepo.rotations)
+	}
+}
+
+func TestAccountPrivateKeyIgnoresLegacyKeyVersion(t *testing.T) {
+	svc, _ := newTestServiceWithMock(t)
+
+	base, err := svc.derivePrivateKey("acc-1", 0)
+	if err != nil {
+		t.Fatalf("derivePrivateKey: %v", err)
+	}
+	got, err := svc.accountPrivateKey(&neoaccountssupabase.Account{ID: "acc-1", KeyVersion: 3})
+	if err != nil {
+		t.Fatalf("accountPrivateKey: %v", err)
+	}
+	if !got.Equal(base) {
+		t.Fatal("key_version must not change the key of a never-rotated account")
+	}
+}
+
+func TestRotateAccountKeyRequiresLock(t *testing.T) {
+	svc, mockRepo := newTestServiceWithMock(t)
+	mockRepo.accounts["acc-1"] = &neoaccountssupabase.Account{ID: "acc-1", Address: "NAddr", LockedBy: "other"}
+
+	if _, err := svc.RotateAccountKey(context.Background(), "neocompute", "acc-1"); !errors.Is(err, ErrAccountNotLocked) {
+		t.Fatalf("err = %v, want ErrAccountNotLocked", err)
+	}
+	if len(mockRepo.rotations) != 0 {
+		t.Fatal("no rotation should be recorded")
+	}
+}
+
+// conflictingRotationRepo simulates losing the conditional account update.
+type conflictingRotationRepo struct {
+	*mockNeoAccountsRepo
+}
+
+func (r *conflictingRotationRepo) TryRotateKey(context.Context, *neoaccountssupabase.Account, string, *neoaccountssupabase.KeyRotation) (bool, error) {
+	return false, nil
+}
+
+func encryptTestWIF(t *testing.T, key []byte, wif string) string {
+	t.Helper()
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		t.Fatalf("NewCipher: %v", err)
+	}
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		t.Fatalf("NewGCM: %v", err)
+	}
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		t.Fatalf("rand: %v", err)
+	}
+	return base64.StdEncoding.EncodeToString(gcm.Seal(nonce, nonce, []byte(wif), nil))
+}
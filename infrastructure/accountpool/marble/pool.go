@@ -291,12 +291,12 @@ func (s *Service) ListAccountsByService(ctx context.Context, serviceID, tokenTyp
 // ListLowBalanceAccounts returns accounts with balance below the specified threshold.
 // This is useful for auto top-up workers that need to find accounts requiring funding.
 // DESIGN: Read-only operation, no mutex needed - data comes from DB.
-func (s *Service) ListLowBalanceAccounts(ctx context.Context, tokenType string, maxBalance int64, limit int) ([]AccountInfo, error) {
+func (s *Service) ListLowBalanceAccounts(ctx context.Context, tokenType string, maxBalance int64, page neoaccountssupabase.LowBalancePage) ([]AccountInfo, error) {
 	if s.repo == nil {
 		return nil, fmt.Errorf("repository not configured")
 	}
 
-	accounts, err := s.repo.ListLowBalanceAccounts(ctx, tokenType, maxBalance, limit)
+	accounts, err := s.repo.ListLowBalanceAccounts(ctx, tokenType, maxBalance, page)
 	if err != nil {
 		return nil, err
 	}
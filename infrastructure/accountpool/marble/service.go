@@ -164,24 +164,20 @@ func allowEphemeralMasterKey() bool {
 
 const secretPoolEncryptionKey = "POOL_ENCRYPTION_KEY"
 
-// loadEncryptionKey loads the encryption key for decrypting stored WIFs.
-// First tries marble.Secret(), then falls back to direct env var lookup.
+// loadEncryptionKey loads the encryption key for decrypting stored WIFs from
+// the Marble secret, falling back to the env var (simulation/development).
+// Raw 32-byte keys are used as-is; anything else must be hex.
 func (s *Service) loadEncryptionKey(m *marble.Marble) error {
-	// Try marble.Secret() first (for production MarbleRun deployments)
-	key, ok := m.Secret(secretPoolEncryptionKey)
-	if ok && len(key) == 32 {
+	key := m.SecretBytesOrEnv(secretPoolEncryptionKey, secretPoolEncryptionKey)
+	if len(key) == 32 {
 		s.encryptionKey = key
 		return nil
 	}
-
-	// Fallback: direct env var lookup (for simulation/development)
-	envValue := strings.TrimSpace(os.Getenv(secretPoolEncryptionKey))
-	if envValue == "" {
+	if len(key) == 0 {
 		return fmt.Errorf("missing %s secret", secretPoolEncryptionKey)
 	}
 
-	// Try hex decoding
-	decoded, err := hex.DecodeString(envValue)
+	decoded, err := hex.DecodeString(strings.TrimSpace(string(key)))
 	if err != nil {
 		return fmt.Errorf("%s is not valid hex: %w", secretPoolEncryptionKey, err)
 	}
@@ -303,20 +299,18 @@ func (s *Service) deriveAccountKey(accountID string) ([]byte, error) {
 	return crypto.DeriveKey(s.masterKey, []byte(accountID), "pool-account", 32)
 }
 
-// getPrivateKey returns the private key for an account.
-// Priority: 1) Stored encrypted WIF, 2) HD derivation from master key.
-// This is internal only - private keys never leave this service.
-func (s *Service) getPrivateKey(accountID string) (*ecdsa.PrivateKey, error) {
-	// Try stored encrypted WIF first (for pre-generated accounts)
-	if s.encryptionKey != nil && s.repo != nil {
-		acc, err := s.repo.GetByID(context.Background(), accountID)
-		if err == nil && acc != nil && acc.EncryptedWIF != "" {
-			return s.decryptWIFToPrivateKey(acc.EncryptedWIF)
-		}
+// deriveAccountKeyVersion derives the key for a given key version. Version 0
+// is the original derivation; rotated keys add the version to the context.
+func (s *Service) deriveAccountKeyVersion(accountID string, version int) ([]byte, error) {
+	if version == 0 {
+		return s.deriveAccountKey(accountID)
 	}
+	return crypto.DeriveKey(s.masterKey, []byte(accountID), fmt.Sprintf("pool-account/v%d", version), 32)
+}
 
-	// Fall back to HD derivation (for legacy accounts)
-	derivedKey, err := s.deriveAccountKey(accountID)
+// derivePrivateKey returns the HD-derived private key for an account key version.
+func (s *Service) derivePrivateKey(accountID string, version int) (*ecdsa.PrivateKey, error) {
+	derivedKey, err := s.deriveAccountKeyVersion(accountID, version)
 	if err != nil {
 		return nil, err
 	}
@@ -329,6 +323,34 @@ func (s *Service) getPrivateKey(accountID string) (*ecdsa.PrivateKey, error) {
 	return &neoPrivKey.PrivateKey, nil
 }
 
+// getPrivateKey returns the current private key for an account.
+// Priority: 1) Stored encrypted WIF, 2) HD derivation from master key.
+// This is internal only - private keys never leave this service.
+func (s *Service) getPrivateKey(accountID string) (*ecdsa.PrivateKey, error) {
+	if s.repo != nil {
+		acc, err := s.repo.GetByID(context.Background(), accountID)
+		if err == nil && acc != nil {
+			return s.accountPrivateKey(acc)
+		}
+	}
+
+	// Fall back to HD derivation (for legacy accounts)
+	return s.derivePrivateKey(accountID, 0)
+}
+
+// accountPrivateKey returns the current private key for a loaded account.
+func (s *Service) accountPrivateKey(acc *neoaccountssupabase.Account) (*ecdsa.PrivateKey, error) {
+	// Keys created by a key rotation are always HD-derived.
+	if acc.RotatedKeyVersion > 0 {
+		return s.derivePrivateKey(acc.ID, acc.RotatedKeyVersion)
+	}
+	// Stored encrypted WIF (for pre-generated accounts)
+	if s.encryptionKey != nil && acc.EncryptedWIF != "" {
+		return s.decryptWIFToPrivateKey(acc.EncryptedWIF)
+	}
+	return s.derivePrivateKey(acc.ID, 0)
+}
+
 // decryptWIFToPrivateKey decrypts an encrypted WIF and returns the private key.
 func (s *Service) decryptWIFToPrivateKey(encryptedWIF string) (*ecdsa.PrivateKey, error) {
 	if s.encryptionKey == nil {
@@ -12,12 +12,14 @@ import (
 	"math/big"
 	"net/http"
 	"net/http/httptest"
+	"sort"
 	"strings"
 	"testing"
 	"time"
 
 	neoaccountssupabase "github.com/R3E-Network/neo-miniapps-platform/infrastructure/accountpool/supabase"
 	"github.com/R3E-Network/neo-miniapps-platform/infrastructure/crypto"
+	"github.com/R3E-Network/neo-miniapps-platform/infrastructure/httputil"
 	"github.com/R3E-Network/neo-miniapps-platform/infrastructure/marble"
 	"github.com/R3E-Network/neo-miniapps-platform/infrastructure/runtime"
 	"github.com/R3E-Network/neo-miniapps-platform/infrastructure/serviceauth"
@@ -31,7 +33,8 @@ import (
 type mockNeoAccountsRepo struct {
 	accounts      map[string]*neoaccountssupabase.Account
 	balances      map[string]map[string]*neoaccountssupabase.AccountBalance // accountID -> tokenType -> balance
-	simulateError bool                                                      // When true, methods return errors
+	rotations     []*neoaccountssupabase.KeyRotation
+	simulateError bool // When true, methods return errors
 }
 
 func newMockNeoAccountsRepo() *mockNeoAccountsRepo {
@@ -134,6 +137,48 @@ func (m *mockNeoAccountsRepo) TryReleaseAccount(_ context.Context, accountID, se
 	return true, nil
 }
 
+func (m *mockNeoAccountsRepo) TryRotateKey(_ context.Context, acc *neoaccountssupabase.Account, serviceID string, rotation *neoaccountssupabase.KeyRotation) (bool, error) {
+	stored, ok := m.accounts[acc.ID]
+	if !ok || stored.LockedBy != serviceID || stored.Address != acc.Address {
+		return false, nil
+	}
+	stored.Address = rotation.NewAddress
+	stored.PublicKey = rotation.NewPublicKey
+	stored.RotatedKeyVersion = rotation.NewKeyVersion
+	stored.EncryptedWIF = ""
+	return true, nil
+}
+
+func (m *mockNeoAccountsRepo) CreateKeyRotation(_ context.Context, rotation *neoaccountssupabase.KeyRotation) error {
+	for _, existing := range m.rotations {
+		if existing.AccountID == rotation.AccountID && existing.NewKeyVersion == rotation.NewKeyVersion {
+			return fmt.Errorf("duplicate key rotation %s/%d", rotation.AccountID, rotation.NewKeyVersion)
+		}
+	}
+	m.rotations = append(m.rotations, rotation)
+	return nil
+}
+
+func (m *mockNeoAccountsRepo) DeleteKeyRotation(_ context.Context, id string) error {
+	for i, existing := range m.rotations {
+		if existing.ID == id {
+			m.rotations = append(m.rotations[:i], m.rotations[i+1:]...)
+			break
+		}
+	}
+	return nil
+}
+
+func (m *mockNeoAccountsRepo) ListKeyRotations(_ context.Context, accountID string) ([]neoaccountssupabase.KeyRotation, error) {
+	var result []neoaccountssupabase.KeyRotation
+	for _, rotation := range m.rotations {
+		if rotation.AccountID == accountID {
+			result = append(result, *rotation)
+		}
+	}
+	return result, nil
+}
+
 func (m *mockNeoAccountsRepo) Delete(_ context.Context, id string) error {
 	delete(m.accounts, id)
 	delete(m.balances, id)
@@ -287,16 +332,34 @@ func (m *mockNeoAccountsRepo) AggregateTokenStats(_ context.Context, tokenType s
 	return stats, nil
 }
 
-// ListLowBalanceAccounts returns accounts with balance below the specified threshold.
-func (m *mockNeoAccountsRepo) ListLowBalanceAccounts(_ context.Context, tokenType string, maxBalance int64, limit int) ([]neoaccountssupabase.AccountWithBalances, error) {
+// ListLowBalanceAccounts returns accounts with balance below the specified threshold,
+// ordered by id.
+func (m *mockNeoAccountsRepo) ListLowBalanceAccounts(_ context.Context, tokenType string, maxBalance int64, page neoaccountssupabase.LowBalancePage) ([]neoaccountssupabase.AccountWithBalances, error) {
 	if m.simulateError {
 		return nil, fmt.Errorf("simulated error")
 	}
+	ids := make([]string, 0, len(m.accounts))
+	for id := range m.accounts {
+		if page.AfterID == "" || id > page.AfterID {
+			ids = append(ids, id)
+		}
+	}
+	sort.Strings(ids)
+	skip := 0
+	if page.AfterID == "" {
+		skip = page.Offset
+	}
+
 	var result []neoaccountssupabase.AccountWithBalances
-	for _, acc := range m.accounts {
+	for _, id := range ids {
+		acc := m.accounts[id]
 		if acc.LockedBy == "" && !acc.IsRetiring {
 			if bals, ok := m.balances[acc.ID]; ok {
 				if bal, ok := bals[tokenType]; ok && bal.Amount < maxBalance {
+					if skip > 0 {
+						skip--
+						continue
+					}
 					awb := neoaccountssupabase.NewAccountWithBalances(acc)
 					for _, b := range bals {
 						awb.Balances[b.TokenType] = neoaccountssupabase.TokenBalance{
@@ -308,7 +371,7 @@ func (m *mockNeoAccountsRepo) ListLowBalanceAccounts(_ context.Context, tokenTyp
 						}
 					}
 					result = append(result, *awb)
-					if len(result) >= limit {
+					if len(result) >= page.Limit {
 						break
 					}
 				}
@@ -1415,6 +1478,52 @@ func TestHandleListAccountsEndpoint(t *testing.T) {
 	}
 }
 
+func TestHandleListLowBalanceAccountsPages(t *testing.T) {
+	m, _ := marble.New(marble.Config{MarbleType: "neoaccounts"})
+	m.SetTestSecret("POOL_MASTER_KEY", []byte("test-master-key-32-bytes-long!!!"))
+
+	mockRepo := newMockNeoAccountsRepo()
+	ctx := context.Background()
+	for _, id := range []string{"acc-1", "acc-2", "acc-3"} {
+		mockRepo.accounts[id] = &neoaccountssupabase.Account{ID: id}
+		mockRepo.UpsertBalance(ctx, id, TokenTypeGAS, neoaccountssupabase.GASScriptHash, 100, 8)
+	}
+	svc, _ := New(Config{Marble: m, NeoAccountsRepo: mockRepo})
+
+	list := func(query string) ([]string, *httptest.ResponseRecorder) {
+		t.Helper()
+		req := httptest.NewRequest("GET", "/accounts/low-balance?max_balance=1000"+query, nil)
+		addServiceAuth(req)
+		rr := httptest.NewRecorder()
+		svc.Router().ServeHTTP(rr, req)
+		var resp ListAccountsResponse
+		_ = json.NewDecoder(rr.Body).Decode(&resp)
+		ids := make([]string, 0, len(resp.Accounts))
+		for _, acc := range resp.Accounts {
+			ids = append(ids, acc.ID)
+		}
+		return ids, rr
+	}
+
+	first, rr := list("&limit=2")
+	if strings.Join(first, ",") != "acc-1,acc-2" {
+		t.Fatalf("first page = %v", first)
+	}
+	next := rr.Header().Get(httputil.NextCursorHeader)
+	if next == "" {
+		t.Fatal("a full page should carry a next cursor")
+	}
+	if second, _ := list("&limit=2&cursor=" + next); strings.Join(second, ",") != "acc-3" {
+		t.Fatalf("second page = %v, want acc-3", second)
+	}
+	if byOffset, _ := list("&limit=2&offset=2"); strings.Join(byOffset, ",") != "acc-3" {
+		t.Fatalf("offset page = %v, want acc-3", byOffset)
+	}
+	if defaulted, rr := list("&limit=0"); rr.Code != http.StatusOK || len(defaulted) != 3 {
+		t.Fatalf("zero limit: status %d, %v; want the default limit", rr.Code, defaulted)
+	}
+}
+
 func TestHandleListAccountsMissingServiceID(t *testing.T) {
 	m, _ := marble.New(marble.Config{MarbleType: "neoaccounts"})
 	m.SetTestSecret("POOL_MASTER_KEY", []byte("test-master-key-32-bytes-long!!!"))
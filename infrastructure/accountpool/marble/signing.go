@@ -72,6 +72,12 @@ func (s *Service) getTEEWalletAccount() (*wallet.Account, error) {
 // SignTransaction signs a transaction hash with an account's private key.
 // The account must be locked by the requesting service.
 func (s *Service) SignTransaction(ctx context.Context, serviceID, accountID string, txHash []byte) (*SignTransactionResponse, error) {
+	return s.signTransactionWithKey(ctx, serviceID, accountID, txHash, nil)
+}
+
+// signTransactionWithKey signs with the given key version, or the current key
+// when keyVersion is nil.
+func (s *Service) signTransactionWithKey(ctx context.Context, serviceID, accountID string, txHash []byte, keyVersion *int) (*SignTransactionResponse, error) {
 	if s.repo == nil {
 		return nil, fmt.Errorf("repository not configured")
 	}
@@ -90,7 +96,11 @@ func (s *Service) SignTransaction(ctx context.Context, serviceID, accountID stri
 		return nil, fmt.Errorf("account not locked by service %s", serviceID)
 	}
 
-	priv, err := s.getPrivateKey(accountID)
+	version := acc.RotatedKeyVersion
+	if keyVersion != nil {
+		version = *keyVersion
+	}
+	priv, err := s.accountPrivateKeyVersion(ctx, acc, version)
 	if err != nil {
 		return nil, fmt.Errorf("derive key: %w", err)
 	}
@@ -442,16 +452,12 @@ func (s *Service) DeployContract(ctx context.Context, serviceID, accountID, nefB
 		return nil, fmt.Errorf("deployment simulation faulted: %s", invokeResult.Exception)
 	}
 
-	// Build and sign the transaction inside TEE
+	// Build, sign and submit the transaction inside TEE
 	txBuilder := chain.NewTxBuilder(s.chainClient, s.chainClient.NetworkID())
-	tx, err := txBuilder.BuildAndSignTx(ctx, invokeResult, signer, transaction.CalledByEntry)
-	if err != nil {
-		return nil, fmt.Errorf("build deployment transaction: %w", err)
-	}
-
-	txHash, err := txBuilder.BroadcastTx(ctx, tx)
+	txBuilder.OnSubmitAttempt(s.SubmitAttemptRecorder(accountID, contractMgmtAddress, "deploy"))
+	txHash, err := txBuilder.SubmitTx(ctx, invokeResult, signer, transaction.CalledByEntry)
 	if err != nil {
-		return nil, fmt.Errorf("broadcast deployment: %w", err)
+		return nil, fmt.Errorf("submit deployment transaction: %w", err)
 	}
 
 	txHashString := "0x" + txHash.StringLE()
@@ -582,16 +588,12 @@ func (s *Service) UpdateContract(ctx context.Context, serviceID, accountID, cont
 		return nil, fmt.Errorf("update simulation faulted: %s", invokeResult.Exception)
 	}
 
-	// Build and sign the transaction inside TEE
+	// Build, sign and submit the transaction inside TEE
 	txBuilder := chain.NewTxBuilder(s.chainClient, s.chainClient.NetworkID())
-	tx, err := txBuilder.BuildAndSignTx(ctx, invokeResult, signer, transaction.CalledByEntry)
-	if err != nil {
-		return nil, fmt.Errorf("build update transaction: %w", err)
-	}
-
-	txHash, err := txBuilder.BroadcastTx(ctx, tx)
+	txBuilder.OnSubmitAttempt(s.SubmitAttemptRecorder(accountID, contractAddress, "update"))
+	txHash, err := txBuilder.SubmitTx(ctx, invokeResult, signer, transaction.CalledByEntry)
 	if err != nil {
-		return nil, fmt.Errorf("broadcast update: %w", err)
+		return nil, fmt.Errorf("submit update transaction: %w", err)
 	}
 
 	txHashString := "0x" + txHash.StringLE()
@@ -720,16 +722,12 @@ func (s *Service) InvokeContract(ctx context.Context, serviceID, accountID, cont
 		}, fmt.Errorf("invocation simulation faulted: %s", invokeResult.Exception)
 	}
 
-	// Build and sign the transaction inside TEE
+	// Build, sign and submit the transaction inside TEE
 	txBuilder := chain.NewTxBuilder(s.chainClient, s.chainClient.NetworkID())
-	tx, err := txBuilder.BuildAndSignTx(ctx, invokeResult, signer, txScope)
+	txBuilder.OnSubmitAttempt(s.SubmitAttemptRecorder(accountID, contractAddress, method))
+	txHash, err := txBuilder.SubmitTx(ctx, invokeResult, signer, txScope)
 	if err != nil {
-		return nil, fmt.Errorf("build invocation transaction: %w", err)
-	}
-
-	txHash, err := txBuilder.BroadcastTx(ctx, tx)
-	if err != nil {
-		return nil, fmt.Errorf("broadcast invocation: %w", err)
+		return nil, fmt.Errorf("submit invocation transaction: %w", err)
 	}
 
 	txHashString := "0x" + txHash.StringLE()
@@ -1060,16 +1058,12 @@ func (s *Service) InvokeMaster(ctx context.Context, contractAddress, method stri
 		}, fmt.Errorf("invocation simulation faulted: %s", invokeResult.Exception)
 	}
 
-	// Build and sign the transaction inside TEE
+	// Build, sign and submit the transaction inside TEE
 	txBuilder := chain.NewTxBuilder(s.chainClient, s.chainClient.NetworkID())
-	tx, err := txBuilder.BuildAndSignTx(ctx, invokeResult, signer, txScope)
+	txBuilder.OnSubmitAttempt(s.SubmitAttemptRecorder("master", contractAddress, method))
+	txHash, err := txBuilder.SubmitTx(ctx, invokeResult, signer, txScope)
 	if err != nil {
-		return nil, fmt.Errorf("build invocation transaction: %w", err)
-	}
-
-	txHash, err := txBuilder.BroadcastTx(ctx, tx)
-	if err != nil {
-		return nil, fmt.Errorf("broadcast invocation: %w", err)
+		return nil, fmt.Errorf("submit invocation transaction: %w", err)
 	}
 
 	txHashString := "0x" + txHash.StringLE()
@@ -1162,16 +1156,12 @@ func (s *Service) DeployMaster(ctx context.Context, nefBase64, manifestJSON stri
 		"gas_estimate": invokeResult.GasConsumed,
 	}).Info("deployment simulation passed, building transaction")
 
-	// Build and sign the transaction inside TEE
+	// Build, sign and submit the transaction inside TEE
 	txBuilder := chain.NewTxBuilder(s.chainClient, s.chainClient.NetworkID())
-	tx, err := txBuilder.BuildAndSignTx(ctx, invokeResult, signer, transaction.CalledByEntry)
-	if err != nil {
-		return nil, fmt.Errorf("build deployment transaction: %w", err)
-	}
-
-	txHash, err := txBuilder.BroadcastTx(ctx, tx)
+	txBuilder.OnSubmitAttempt(s.SubmitAttemptRecorder("master", contractMgmtAddress, "deploy"))
+	txHash, err := txBuilder.SubmitTx(ctx, invokeResult, signer, transaction.CalledByEntry)
 	if err != nil {
-		return nil, fmt.Errorf("broadcast deployment: %w", err)
+		return nil, fmt.Errorf("submit deployment transaction: %w", err)
 	}
 
 	txHashString := "0x" + txHash.StringLE()
@@ -39,6 +39,12 @@ type SignTransactionInput = neoaccountstypes.SignTransactionInput
 // SignTransactionResponse returns the signature.
 type SignTransactionResponse = neoaccountstypes.SignTransactionResponse
 
+// RotateKeyInput for rotating the key of a locked account.
+type RotateKeyInput = neoaccountstypes.RotateKeyInput
+
+// RotateKeyResponse returns the new key and rotation proof.
+type RotateKeyResponse = neoaccountstypes.RotateKeyResponse
+
 // BatchSignInput for signing multiple transactions.
 type BatchSignInput = neoaccountstypes.BatchSignInput
 
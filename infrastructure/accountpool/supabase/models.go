@@ -36,6 +36,30 @@ type Account struct {
 	IsRetiring   bool      `json:"is_retiring"`
 	LockedBy     string    `json:"locked_by,omitempty"`
 	LockedAt     time.Time `json:"locked_at,omitempty"`
+
+	// RotatedKeyVersion is the version of the account's current key after a
+	// key rotation. 0 means the original key (EncryptedWIF or base derivation);
+	// older keys are kept in pool_account_key_rotations.
+	RotatedKeyVersion int `json:"rotated_key_version,omitempty"`
+}
+
+// KeyRotation is an audit record of an account key rotation, and the account's
+// key history: OldEncryptedWIF keeps the replaced key of pre-generated accounts.
+// Stored in pool_account_key_rotations table, unique on (account_id, new_key_version).
+type KeyRotation struct {
+	ID              string    `json:"id"`
+	AccountID       string    `json:"account_id"`
+	ServiceID       string    `json:"service_id"`
+	OldAddress      string    `json:"old_address"`
+	NewAddress      string    `json:"new_address"`
+	OldKeyVersion   int       `json:"old_key_version"`
+	NewKeyVersion   int       `json:"new_key_version"`
+	OldPublicKey    string    `json:"old_public_key"`
+	NewPublicKey    string    `json:"new_public_key"`
+	OldEncryptedWIF string    `json:"old_encrypted_wif,omitempty"`
+	Proof           string    `json:"proof"` // hex signature by the old key
+	RotatedAt       time.Time `json:"rotated_at"`
+	GraceUntil      time.Time `json:"grace_until"`
 }
 
 // AccountBalance represents a per-token balance for an account.
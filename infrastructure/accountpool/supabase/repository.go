@@ -11,8 +11,9 @@ import (
 )
 
 const (
-	tableName         = "pool_accounts"
-	balancesTableName = "pool_account_balances"
+	tableName          = "pool_accounts"
+	balancesTableName  = "pool_account_balances"
+	rotationsTableName = "pool_account_key_rotations"
 )
 
 // RepositoryInterface defines NeoAccounts-specific data access methods.
@@ -30,12 +31,20 @@ type RepositoryInterface interface {
 	TryReleaseAccount(ctx context.Context, accountID, serviceID string) (bool, error)
 	Delete(ctx context.Context, id string) error
 
+	// Key rotation
+	// TryRotateKey atomically switches acc to the rotation's new key if it is
+	// still locked by serviceID and still has acc's address.
+	TryRotateKey(ctx context.Context, acc *Account, serviceID string, rotation *KeyRotation) (bool, error)
+	CreateKeyRotation(ctx context.Context, rotation *KeyRotation) error
+	DeleteKeyRotation(ctx context.Context, id string) error
+	ListKeyRotations(ctx context.Context, accountID string) ([]KeyRotation, error)
+
 	// Balance-aware account operations
 	GetWithBalances(ctx context.Context, id string) (*AccountWithBalances, error)
 	ListWithBalances(ctx context.Context) ([]AccountWithBalances, error)
 	ListAvailableWithBalances(ctx context.Context, tokenType string, minBalance *int64, limit int) ([]AccountWithBalances, error)
 	ListByLockerWithBalances(ctx context.Context, lockerID string) ([]AccountWithBalances, error)
-	ListLowBalanceAccounts(ctx context.Context, tokenType string, maxBalance int64, limit int) ([]AccountWithBalances, error)
+	ListLowBalanceAccounts(ctx context.Context, tokenType string, maxBalance int64, page LowBalancePage) ([]AccountWithBalances, error)
 
 	// Balance operations
 	UpsertBalance(ctx context.Context, accountID, tokenType, scriptHash string, amount int64, decimals int) error
@@ -194,6 +203,82 @@ func (r *Repository) Delete(ctx context.Context, id string) error {
 	return database.GenericDelete(r.base, ctx, tableName, "id", id)
 }
 
+// =============================================================================
+// Key Rotation
+// =============================================================================
+
+// TryRotateKey moves acc to the rotation's new key. The replaced key is kept
+// in the rotation record (see CreateKeyRotation), so the encrypted WIF is
+// cleared here. The update only applies while the account is locked by
+// serviceID and its address is unchanged, so concurrent rotations cannot both
+// succeed. Returns true when this call rotated the key.
+func (r *Repository) TryRotateKey(ctx context.Context, acc *Account, serviceID string, rotation *KeyRotation) (bool, error) {
+	if acc == nil || rotation == nil {
+		return false, fmt.Errorf("account and rotation are required")
+	}
+	if acc.ID == "" || serviceID == "" {
+		return false, fmt.Errorf("account_id and service_id are required")
+	}
+
+	update := map[string]interface{}{
+		"address":             rotation.NewAddress,
+		"public_key":          rotation.NewPublicKey,
+		"rotated_key_version": rotation.NewKeyVersion,
+		"encrypted_wif":       nil,
+	}
+
+	query := database.NewQuery().
+		Eq("id", acc.ID).
+		Eq("locked_by", serviceID).
+		Eq("address", acc.Address).
+		Build()
+
+	data, err := r.base.Request(ctx, "PATCH", tableName, update, query)
+	if err != nil {
+		return false, err
+	}
+
+	var rows []Account
+	if err := json.Unmarshal(data, &rows); err != nil {
+		return false, fmt.Errorf("unmarshal rotate response: %w", err)
+	}
+
+	return len(rows) > 0, nil
+}
+
+// CreateKeyRotation records a key rotation in the account's key history.
+// It must be written before TryRotateKey so no rotation can apply without an
+// audit record; the unique (account_id, new_key_version) key rejects a
+// concurrent rotation to the same version.
+func (r *Repository) CreateKeyRotation(ctx context.Context, rotation *KeyRotation) error {
+	if rotation == nil {
+		return fmt.Errorf("rotation cannot be nil")
+	}
+	return database.GenericCreate(r.base, ctx, rotationsTableName, rotation, nil)
+}
+
+// DeleteKeyRotation removes a rotation record whose account switch did not apply.
+func (r *Repository) DeleteKeyRotation(ctx context.Context, id string) error {
+	if id == "" {
+		return fmt.Errorf("id is required")
+	}
+	return database.GenericDelete(r.base, ctx, rotationsTableName, "id", id)
+}
+
+// ListKeyRotations returns an account's key history, oldest rotation first.
+func (r *Repository) ListKeyRotations(ctx context.Context, accountID string) ([]KeyRotation, error) {
+	if accountID == "" {
+		return nil, fmt.Errorf("account_id is required")
+	}
+
+	query := database.NewQuery().
+		Eq("account_id", accountID).
+		OrderAsc("new_key_version").
+		Build()
+
+	return database.GenericListWithQuery[KeyRotation](r.base, ctx, rotationsTableName, query)
+}
+
 // =============================================================================
 // Balance-Aware Account Operations
 // =============================================================================
@@ -281,15 +366,33 @@ func (r *Repository) ListByLockerWithBalances(ctx context.Context, lockerID stri
 	return r.hydrateAccountsWithBalances(ctx, accounts)
 }
 
+// MaxLowBalancePageSize is the largest page ListLowBalanceAccounts returns.
+const MaxLowBalancePageSize = 100
+
+// LowBalancePage selects a page of low-balance accounts, ordered by id.
+// When AfterID is set the page starts after that account and Offset is
+// ignored.
+type LowBalancePage struct {
+	Limit   int
+	Offset  int // matching accounts to skip
+	AfterID string
+}
+
 // ListLowBalanceAccounts returns accounts with balance below the specified threshold.
 // This is useful for auto top-up workers that need to find accounts requiring funding.
-func (r *Repository) ListLowBalanceAccounts(ctx context.Context, tokenType string, maxBalance int64, limit int) ([]AccountWithBalances, error) {
-	if limit <= 0 || limit > 100 {
+func (r *Repository) ListLowBalanceAccounts(ctx context.Context, tokenType string, maxBalance int64, page LowBalancePage) ([]AccountWithBalances, error) {
+	limit := page.Limit
+	if limit <= 0 || limit > MaxLowBalancePageSize {
 		limit = 10
 	}
 
-	// Get all accounts (we need to check balances)
-	accounts, err := r.List(ctx)
+	// Balances live in another table, so the threshold is applied after
+	// loading; only the keyset position can be pushed into the query.
+	query := database.NewQuery().OrderAsc("id")
+	if page.AfterID != "" {
+		query = query.Gt("id", page.AfterID)
+	}
+	accounts, err := database.GenericListWithQuery[Account](r.base, ctx, tableName, query.Build())
 	if err != nil {
 		return nil, err
 	}
@@ -300,6 +403,11 @@ func (r *Repository) ListLowBalanceAccounts(ctx context.Context, tokenType strin
 		return nil, err
 	}
 
+	skip := 0
+	if page.AfterID == "" {
+		skip = page.Offset
+	}
+
 	// Filter by token balance below threshold
 	filtered := make([]AccountWithBalances, 0, limit)
 	for i := range accountsWithBalances {
@@ -311,6 +419,10 @@ func (r *Repository) ListLowBalanceAccounts(ctx context.Context, tokenType strin
 		// Check if balance is below threshold
 		balance := acc.GetBalance(tokenType)
 		if balance < maxBalance {
+			if skip > 0 {
+				skip--
+				continue
+			}
 			filtered = append(filtered, *acc)
 			if len(filtered) >= limit {
 				break
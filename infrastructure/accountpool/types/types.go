@@ -76,6 +76,9 @@ type SignTransactionInput struct {
 	ServiceID string `json:"service_id"`
 	AccountID string `json:"account_id"`
 	TxHash    []byte `json:"tx_hash"` // Transaction hash to sign (base64 in JSON)
+	// KeyVersion selects the account's previous key during its post-rotation
+	// grace period. Omit to sign with the current key.
+	KeyVersion *int `json:"key_version,omitempty"`
 }
 
 // SignTransactionResponse returns the signature.
@@ -85,6 +88,27 @@ type SignTransactionResponse struct {
 	PublicKey []byte `json:"public_key"` // base64 in JSON (compressed)
 }
 
+// RotateKeyInput rotates the key of an account locked by the service.
+type RotateKeyInput struct {
+	ServiceID string `json:"service_id"`
+	AccountID string `json:"account_id"`
+}
+
+// RotateKeyResponse returns the new key and a proof of the rotation signed by
+// the old key. The proof signs the KeyRotationDigest of the rotation.
+type RotateKeyResponse struct {
+	AccountID     string    `json:"account_id"`
+	OldAddress    string    `json:"old_address"`
+	NewAddress    string    `json:"new_address"`
+	OldKeyVersion int       `json:"old_key_version"`
+	NewKeyVersion int       `json:"new_key_version"`
+	OldPublicKey  []byte    `json:"old_public_key"` // base64 in JSON (compressed)
+	NewPublicKey  []byte    `json:"new_public_key"` // base64 in JSON (compressed)
+	Proof         []byte    `json:"proof"`          // base64 in JSON
+	RotatedAt     time.Time `json:"rotated_at"`
+	GraceUntil    time.Time `json:"grace_until"`
+}
+
 // BatchSignInput signs multiple transaction hashes.
 type BatchSignInput struct {
 	ServiceID string        `json:"service_id"`
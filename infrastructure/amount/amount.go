@@ -0,0 +1,128 @@
+// Package amount provides an arbitrary-precision integer amount for token
+// values in their smallest unit (e.g. GAS fractions at 8 decimals).
+package amount
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+// Amount is an immutable integer amount. The zero value is 0. Amounts are
+// encoded in JSON as decimal strings so large values survive JavaScript
+// clients; a bare JSON integer is also accepted on input.
+type Amount struct {
+	v *big.Int
+}
+
+// Zero is the zero amount.
+var Zero = Amount{}
+
+// New returns the amount for an int64 value.
+func New(v int64) Amount {
+	return Amount{v: big.NewInt(v)}
+}
+
+// FromBig returns the amount for v. v is copied; nil is treated as 0.
+func FromBig(v *big.Int) Amount {
+	if v == nil {
+		return Zero
+	}
+	return Amount{v: new(big.Int).Set(v)}
+}
+
+// Parse parses a base-10 integer string, with an optional sign.
+func Parse(s string) (Amount, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return Zero, fmt.Errorf("amount: empty value")
+	}
+	v, ok := new(big.Int).SetString(s, 10)
+	if !ok {
+		return Zero, fmt.Errorf("amount: invalid integer %q", s)
+	}
+	return Amount{v: v}, nil
+}
+
+func (a Amount) big() *big.Int {
+	if a.v == nil {
+		return new(big.Int)
+	}
+	return a.v
+}
+
+// BigInt returns a copy of the amount as a big.Int.
+func (a Amount) BigInt() *big.Int {
+	return new(big.Int).Set(a.big())
+}
+
+// Int64 returns the amount as an int64 and whether it fits.
+func (a Amount) Int64() (int64, bool) {
+	v := a.big()
+	return v.Int64(), v.IsInt64()
+}
+
+// Add returns a + b.
+func (a Amount) Add(b Amount) Amount {
+	return Amount{v: new(big.Int).Add(a.big(), b.big())}
+}
+
+// Sub returns a - b.
+func (a Amount) Sub(b Amount) Amount {
+	return Amount{v: new(big.Int).Sub(a.big(), b.big())}
+}
+
+// Cmp compares a and b and returns -1, 0 or +1.
+func (a Amount) Cmp(b Amount) int {
+	return a.big().Cmp(b.big())
+}
+
+// Sign returns -1, 0 or +1 depending on the sign of a.
+func (a Amount) Sign() int {
+	return a.big().Sign()
+}
+
+// IsZero reports whether a is 0.
+func (a Amount) IsZero() bool {
+	return a.Sign() == 0
+}
+
+// IsNegative reports whether a is below 0.
+func (a Amount) IsNegative() bool {
+	return a.Sign() < 0
+}
+
+// String returns the base-10 representation.
+func (a Amount) String() string {
+	return a.big().String()
+}
+
+// MarshalJSON encodes the amount as a decimal string.
+func (a Amount) MarshalJSON() ([]byte, error) {
+	return json.Marshal(a.String())
+}
+
+// UnmarshalJSON accepts a decimal string or a JSON integer.
+func (a *Amount) UnmarshalJSON(data []byte) error {
+	data = bytes.TrimSpace(data)
+	if bytes.Equal(data, []byte("null")) {
+		*a = Zero
+		return nil
+	}
+	var s string
+	if len(data) > 0 && data[0] == '"' {
+		if err := json.Unmarshal(data, &s); err != nil {
+			return fmt.Errorf("amount: %w", err)
+		}
+	} else {
+		s = string(data)
+	}
+	parsed, err := Parse(s)
+	if err != nil {
+		return err
+	}
+	*a = parsed
+	return nil
+}
@@ -0,0 +1,89 @@
+package amount
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    string
+		wantErr bool
+	}{
+		{"0", "0", false},
+		{" 100000000 ", "100000000", false},
+		{"-5", "-5", false},
+		{"123456789012345678901234567890", "123456789012345678901234567890", false},
+		{"", "", true},
+		{"1.5", "", true},
+		{"1e8", "", true},
+		{"0x10", "", true},
+	}
+
+	for _, tt := range tests {
+		got, err := Parse(tt.in)
+		if (err != nil) != tt.wantErr {
+			t.Fatalf("Parse(%q) error = %v, wantErr %v", tt.in, err, tt.wantErr)
+		}
+		if err == nil && got.String() != tt.want {
+			t.Fatalf("Parse(%q) = %s, want %s", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestArithmetic(t *testing.T) {
+	big1, _ := Parse("9223372036854775807") // max int64
+	sum := big1.Add(New(1))
+	if sum.String() != "9223372036854775808" {
+		t.Fatalf("sum = %s, want no int64 overflow", sum)
+	}
+	if _, ok := sum.Int64(); ok {
+		t.Fatal("sum should not fit in int64")
+	}
+	if diff := New(3).Sub(New(5)); !diff.IsNegative() || diff.String() != "-2" {
+		t.Fatalf("diff = %s, want -2", diff)
+	}
+	if New(2).Cmp(New(10)) != -1 || Zero.Cmp(New(0)) != 0 || !Zero.IsZero() {
+		t.Fatal("unexpected comparison results")
+	}
+
+	// Operations must not alias their inputs.
+	src := big.NewInt(7)
+	a := FromBig(src)
+	src.SetInt64(0)
+	a.BigInt().SetInt64(0)
+	if a.String() != "7" {
+		t.Fatalf("a = %s, want 7", a)
+	}
+}
+
+func TestJSON(t *testing.T) {
+	type payload struct {
+		Amount Amount `json:"amount"`
+	}
+
+	out, err := json.Marshal(payload{Amount: New(150000000)})
+	if err != nil {
+		t.Fatalf("Marshal: %v", err)
+	}
+	if string(out) != `{"amount":"150000000"}` {
+		t.Fatalf("Marshal = %s", out)
+	}
+
+	for _, in := range []string{`{"amount":"150000000"}`, `{"amount":150000000}`} {
+		var p payload
+		if err := json.Unmarshal([]byte(in), &p); err != nil {
+			t.Fatalf("Unmarshal(%s): %v", in, err)
+		}
+		if p.Amount.String() != "150000000" {
+			t.Fatalf("Unmarshal(%s) = %s", in, p.Amount)
+		}
+	}
+
+	var p payload
+	if err := json.Unmarshal([]byte(`{"amount":"1.5"}`), &p); err == nil {
+		t.Fatal("expected error for fractional amount")
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"math/big"
 	"net/http"
@@ -12,6 +13,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/nspcc-dev/neo-go/pkg/core/transaction"
 	"github.com/nspcc-dev/neo-go/pkg/rpcclient"
 	"github.com/nspcc-dev/neo-go/pkg/rpcclient/actor"
 	"github.com/nspcc-dev/neo-go/pkg/rpcclient/gas"
@@ -31,6 +33,17 @@ type Client struct {
 	// Key: account script hash hex string
 	actorCache map[string]*actorEntry
 	actorMu    sync.Mutex
+
+	// Shared transaction-builder state: nonce / ValidUntilBlock, fee strategy
+	// resubmission limit and attempt recorder
+	txCtx        *TxContext
+	feeStrategy  FeeStrategy
+	maxResubmits int
+	onAttempt    func(context.Context, SubmitAttempt)
+	txCtxMu      sync.Mutex
+
+	// Optional endpoint pool; when set, calls fail over between endpoints.
+	pool *RPCPool
 }
 
 // actorEntry holds an actor and its RPC client for cleanup
@@ -82,6 +95,8 @@ func NewClient(cfg Config) (*Client, error) {
 		httpClient: httpClient,
 		networkID:  cfg.NetworkID,
 		actorCache: make(map[string]*actorEntry),
+
+		maxResubmits: DefaultMaxResubmits,
 	}, nil
 }
 
@@ -131,7 +146,15 @@ func (c *Client) Call(ctx context.Context, method string, params []interface{})
 		return nil, fmt.Errorf("marshal request: %w", err)
 	}
 
-	httpReq, err := http.NewRequestWithContext(ctx, "POST", c.rpcURL, bytes.NewReader(body))
+	if c.pool != nil {
+		return c.callWithFailover(ctx, body)
+	}
+	return c.callEndpoint(ctx, c.rpcURL, body)
+}
+
+// callEndpoint posts an encoded JSON-RPC request to a single endpoint.
+func (c *Client) callEndpoint(ctx context.Context, rpcURL string, body []byte) (json.RawMessage, error) {
+	httpReq, err := http.NewRequestWithContext(ctx, "POST", rpcURL, bytes.NewReader(body))
 	if err != nil {
 		return nil, fmt.Errorf("create request: %w", err)
 	}
@@ -237,6 +260,8 @@ func (c *Client) TransferGAS(ctx context.Context, account *wallet.Account, to ut
 // TransferGASWithData transfers GAS from a signer account to a target address with optional data.
 // The data parameter is passed to the OnNEP17Payment callback of the receiving contract.
 // This is used for payments to contracts like PaymentHub that need to identify the payment source.
+// The network fee follows the client's FeeStrategy; a fee above MaxFee fails
+// with ErrNetworkFeeTooHigh before anything is sent.
 func (c *Client) TransferGASWithData(ctx context.Context, account *wallet.Account, to util.Uint160, amount *big.Int, data any) (util.Uint256, error) {
 	// Get or create the actor (hold lock only during setup)
 	act, err := c.getOrCreateActor(ctx, account)
@@ -250,8 +275,11 @@ func (c *Client) TransferGASWithData(ctx context.Context, account *wallet.Accoun
 	// Transfer GAS with data - this can run concurrently, actor handles nonce management
 	txHash, _, err := gasContract.Transfer(account.ScriptHash(), to, amount, data)
 	if err != nil {
-		// If transfer fails, reset the actor so it gets recreated on next call
-		c.resetActor()
+		// If transfer fails, reset the actor so it gets recreated on next call.
+		// A fee refusal happens before sending and leaves the actor usable.
+		if !errors.Is(err, ErrNetworkFeeTooHigh) && !errors.Is(err, ErrNetworkFeeTooLow) {
+			c.resetActor()
+		}
 		return util.Uint256{}, fmt.Errorf("transfer: %w", err)
 	}
 
@@ -279,7 +307,7 @@ func (c *Client) getOrCreateActor(ctx context.Context, account *wallet.Account)
 	}
 
 	// Create a new rpcclient connection for this account
-	rpcClient, err := rpcclient.New(ctx, c.rpcURL, rpcclient.Options{
+	rpcClient, err := rpcclient.New(ctx, c.endpointURL(), rpcclient.Options{
 		RequestTimeout: timeout,
 		DialTimeout:    timeout,
 	})
@@ -287,8 +315,14 @@ func (c *Client) getOrCreateActor(ctx context.Context, account *wallet.Account)
 		return nil, fmt.Errorf("create rpc client: %w", err)
 	}
 
-	// Create actor for signing transactions
-	act, err := actor.NewSimple(rpcClient, account)
+	// Create actor for signing transactions; its fees follow the client's FeeStrategy.
+	act, err := actor.NewTuned(rpcClient, []actor.SignerAccount{{
+		Signer: transaction.Signer{
+			Account: account.ScriptHash(),
+			Scopes:  transaction.CalledByEntry,
+		},
+		Account: account,
+	}}, c.actorOptions())
 	if err != nil {
 		rpcClient.Close()
 		return nil, fmt.Errorf("create actor: %w", err)
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"io"
 	"math/big"
 	"net/http"
@@ -304,6 +305,67 @@ func TestWaitForApplicationLogTimeout(t *testing.T) {
 	}
 }
 
+func TestWaitForConfirmation(t *testing.T) {
+	blockCount := 100
+	logCalls := 0
+
+	client, _ := NewClient(Config{RPCURL: "http://example"})
+	client.httpClient.Transport = roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+		var req RPCRequest
+		_ = json.NewDecoder(r.Body).Decode(&req)
+
+		resp := RPCResponse{JSONRPC: "2.0", ID: req.ID}
+		switch req.Method {
+		case "getapplicationlog":
+			logCalls++
+			if logCalls < 2 {
+				resp.Error = &RPCError{Code: -100, Message: "Unknown transaction"}
+				break
+			}
+			log := ApplicationLog{TxID: "0xabc", Executions: []Execution{{VMState: "FAULT", Exception: "boom"}}}
+			resp.Result, _ = json.Marshal(log)
+		case "gettransactionheight":
+			resp.Result = json.RawMessage(`99`) // included in the latest block
+		case "getblockcount":
+			resp.Result, _ = json.Marshal(blockCount)
+			blockCount++
+		default:
+			resp.Error = &RPCError{Code: -1, Message: "unknown"}
+		}
+
+		payload, _ := json.Marshal(resp)
+		return newResponse(payload), nil
+	})
+
+	log, err := client.waitForConfirmation(context.Background(), "0xabc", 3, time.Millisecond)
+	if err != nil {
+		t.Fatalf("waitForConfirmation error: %v", err)
+	}
+	if log.Executions[0].VMState != "FAULT" || log.Executions[0].Exception != "boom" {
+		t.Fatalf("unexpected log %+v", log)
+	}
+	// Height 99 needs a block count of 102 for three confirmations.
+	if blockCount != 103 {
+		t.Fatalf("block count polled up to %d, want 102", blockCount-1)
+	}
+}
+
+func TestWaitForConfirmationTimeout(t *testing.T) {
+	client, _ := NewClient(Config{RPCURL: "http://example"})
+	client.httpClient.Transport = roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+		resp := RPCResponse{JSONRPC: "2.0", ID: 1, Error: &RPCError{Code: -100, Message: "Unknown transaction"}}
+		payload, _ := json.Marshal(resp)
+		return newResponse(payload), nil
+	})
+
+	wctx, cancel := context.WithTimeout(context.Background(), time.Millisecond*50)
+	defer cancel()
+	_, err := client.waitForConfirmation(wctx, "0xabc", 1, time.Millisecond*10)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected deadline exceeded, got %v", err)
+	}
+}
+
 func TestParseByteArrayNull(t *testing.T) {
 	item := StackItem{
 		Type:  "Null",
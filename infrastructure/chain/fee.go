@@ -0,0 +1,202 @@
+package chain
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/nspcc-dev/neo-go/pkg/core/transaction"
+	"github.com/nspcc-dev/neo-go/pkg/neorpc/result"
+	"github.com/nspcc-dev/neo-go/pkg/rpcclient/actor"
+)
+
+// =============================================================================
+// Network Fee Strategy
+// =============================================================================
+
+// FeeMode selects how TxBuilder turns the node's required network fee into the
+// fee it attaches to a transaction.
+type FeeMode string
+
+const (
+	// FeeModeNetwork pays the required fee plus the builder's buffer (default).
+	FeeModeNetwork FeeMode = "network"
+	// FeeModeMultiplier pays the required fee times Multiplier plus the buffer,
+	// for bumping priority during congestion.
+	FeeModeMultiplier FeeMode = "multiplier"
+	// FeeModeFixed pays exactly Fixed, failing if that is below the required fee.
+	FeeModeFixed FeeMode = "fixed"
+	// FeeModeCapped pays the required fee plus the buffer, trimming the buffer
+	// so the total never exceeds MaxFee.
+	FeeModeCapped FeeMode = "capped"
+)
+
+// defaultNetworkFeeBuffer is added to the node's required network fee
+// (0.001 GAS).
+const defaultNetworkFeeBuffer = 100000
+
+var (
+	// ErrNetworkFeeTooHigh is returned when a transaction would need more than
+	// the configured maximum network fee.
+	ErrNetworkFeeTooHigh = errors.New("network fee exceeds configured maximum")
+	// ErrNetworkFeeTooLow is returned when a fixed fee is below the fee the
+	// network requires for the transaction.
+	ErrNetworkFeeTooLow = errors.New("fixed network fee below required fee")
+)
+
+// FeeStrategy configures network fee selection. Amounts are in GAS fractions
+// (1 GAS = 10^8). The zero value is FeeModeNetwork with no ceiling.
+type FeeStrategy struct {
+	Mode       FeeMode
+	Multiplier float64 // FeeModeMultiplier; must be >= 1
+	Fixed      int64   // FeeModeFixed
+	// MaxFee aborts building when the chosen fee would exceed it. 0 disables
+	// the ceiling, except in FeeModeCapped where it is required.
+	MaxFee int64
+}
+
+// Validate reports configuration errors.
+func (s FeeStrategy) Validate() error {
+	switch s.Mode {
+	case "", FeeModeNetwork:
+	case FeeModeMultiplier:
+		if s.Multiplier < 1 || math.IsInf(s.Multiplier, 0) || math.IsNaN(s.Multiplier) {
+			return fmt.Errorf("fee multiplier must be >= 1, got %v", s.Multiplier)
+		}
+	case FeeModeFixed:
+		if s.Fixed <= 0 {
+			return fmt.Errorf("fixed network fee must be positive")
+		}
+	case FeeModeCapped:
+		if s.MaxFee <= 0 {
+			return fmt.Errorf("capped fee mode requires a maximum fee")
+		}
+	default:
+		return fmt.Errorf("unknown fee mode %q", s.Mode)
+	}
+	if s.MaxFee < 0 {
+		return fmt.Errorf("maximum network fee must not be negative")
+	}
+	return nil
+}
+
+// NetworkFee returns the network fee to attach given the fee the node requires
+// and the builder's safety buffer.
+func (s FeeStrategy) NetworkFee(required, buffer int64) (int64, error) {
+	if err := s.Validate(); err != nil {
+		return 0, err
+	}
+
+	var fee int64
+	switch s.Mode {
+	case FeeModeMultiplier:
+		scaled := math.Ceil(float64(required) * s.Multiplier)
+		if scaled >= math.MaxInt64 {
+			return 0, fmt.Errorf("%w: %v x %d overflows", ErrNetworkFeeTooHigh, s.Multiplier, required)
+		}
+		fee = int64(scaled) + buffer
+	case FeeModeFixed:
+		if s.Fixed < required {
+			return 0, fmt.Errorf("%w: fixed %d < required %d", ErrNetworkFeeTooLow, s.Fixed, required)
+		}
+		fee = s.Fixed
+	case FeeModeCapped:
+		fee = required + buffer
+		if fee > s.MaxFee && required <= s.MaxFee {
+			fee = s.MaxFee
+		}
+	default:
+		fee = required + buffer
+	}
+
+	if s.MaxFee > 0 && fee > s.MaxFee {
+		return 0, fmt.Errorf("%w: need %d, maximum %d", ErrNetworkFeeTooHigh, fee, s.MaxFee)
+	}
+	return fee, nil
+}
+
+// FeeStrategyFromEnv loads a FeeStrategy from:
+//
+//	NEO_FEE_MODE            network | multiplier | fixed | capped (default network)
+//	NEO_FEE_MULTIPLIER      multiplier for "multiplier" mode, e.g. 1.5
+//	NEO_FEE_FIXED           fee for "fixed" mode, in GAS ("0.05") or fractions
+//	NEO_MAX_NETWORK_FEE     ceiling in GAS or fractions; 0/unset disables
+func FeeStrategyFromEnv() (FeeStrategy, error) {
+	s := FeeStrategy{Mode: FeeMode(strings.ToLower(strings.TrimSpace(os.Getenv("NEO_FEE_MODE"))))}
+
+	if raw := strings.TrimSpace(os.Getenv("NEO_FEE_MULTIPLIER")); raw != "" {
+		multiplier, err := strconv.ParseFloat(raw, 64)
+		if err != nil {
+			return FeeStrategy{}, fmt.Errorf("invalid NEO_FEE_MULTIPLIER %q: %w", raw, err)
+		}
+		s.Multiplier = multiplier
+	}
+	if raw := strings.TrimSpace(os.Getenv("NEO_FEE_FIXED")); raw != "" {
+		fixed, err := parseGasValue(raw)
+		if err != nil {
+			return FeeStrategy{}, fmt.Errorf("invalid NEO_FEE_FIXED %q: %w", raw, err)
+		}
+		s.Fixed = fixed
+	}
+	if raw := strings.TrimSpace(os.Getenv("NEO_MAX_NETWORK_FEE")); raw != "" {
+		maxFee, err := parseGasValue(raw)
+		if err != nil {
+			return FeeStrategy{}, fmt.Errorf("invalid NEO_MAX_NETWORK_FEE %q: %w", raw, err)
+		}
+		s.MaxFee = maxFee
+	}
+
+	if err := s.Validate(); err != nil {
+		return FeeStrategy{}, err
+	}
+	return s, nil
+}
+
+// SetFeeStrategy sets the fee strategy used by TxBuilders created for this
+// client afterwards.
+func (c *Client) SetFeeStrategy(s FeeStrategy) error {
+	if err := s.Validate(); err != nil {
+		return err
+	}
+	c.txCtxMu.Lock()
+	c.feeStrategy = s
+	c.txCtxMu.Unlock()
+	return nil
+}
+
+// FeeStrategy returns the client's fee strategy.
+func (c *Client) FeeStrategy() FeeStrategy {
+	if c == nil {
+		return FeeStrategy{}
+	}
+	c.txCtxMu.Lock()
+	defer c.txCtxMu.Unlock()
+	return c.feeStrategy
+}
+
+// actorOptions makes transactions built by the client's neo-go actors (GAS
+// transfers) follow the client's fee strategy like TxBuilder does. The
+// strategy is read per transaction, so SetFeeStrategy also applies to actors
+// that are already cached.
+func (c *Client) actorOptions() actor.Options {
+	applyFee := func(tx *transaction.Transaction) error {
+		fee, err := c.FeeStrategy().NetworkFee(tx.NetworkFee, defaultNetworkFeeBuffer)
+		if err != nil {
+			return err
+		}
+		tx.NetworkFee = fee
+		return nil
+	}
+	return actor.Options{
+		CheckerModifier: func(r *result.Invoke, tx *transaction.Transaction) error {
+			if err := actor.DefaultCheckerModifier(r, tx); err != nil {
+				return err
+			}
+			return applyFee(tx)
+		},
+		Modifier: applyFee,
+	}
+}
@@ -0,0 +1,125 @@
+package chain
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/nspcc-dev/neo-go/pkg/core/transaction"
+	"github.com/nspcc-dev/neo-go/pkg/neorpc/result"
+	"github.com/nspcc-dev/neo-go/pkg/vm/vmstate"
+)
+
+func TestFeeStrategyNetworkFee(t *testing.T) {
+	const required, buffer = 1_000_000, 100_000
+
+	tests := []struct {
+		name     string
+		strategy FeeStrategy
+		want     int64
+		wantErr  error
+	}{
+		{"zero value adds buffer", FeeStrategy{}, 1_100_000, nil},
+		{"multiplier", FeeStrategy{Mode: FeeModeMultiplier, Multiplier: 1.5}, 1_600_000, nil},
+		{"fixed", FeeStrategy{Mode: FeeModeFixed, Fixed: 5_000_000}, 5_000_000, nil},
+		{"fixed below required", FeeStrategy{Mode: FeeModeFixed, Fixed: 500_000}, 0, ErrNetworkFeeTooLow},
+		{"capped trims buffer", FeeStrategy{Mode: FeeModeCapped, MaxFee: 1_050_000}, 1_050_000, nil},
+		{"capped below required", FeeStrategy{Mode: FeeModeCapped, MaxFee: 900_000}, 0, ErrNetworkFeeTooHigh},
+		{"ceiling aborts multiplier", FeeStrategy{Mode: FeeModeMultiplier, Multiplier: 3, MaxFee: 2_000_000}, 0, ErrNetworkFeeTooHigh},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.strategy.NetworkFee(required, buffer)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("NetworkFee() error = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("NetworkFee() error = %v", err)
+			}
+			if got != tt.want {
+				t.Fatalf("NetworkFee() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFeeStrategyValidate(t *testing.T) {
+	invalid := []FeeStrategy{
+		{Mode: "turbo"},
+		{Mode: FeeModeMultiplier, Multiplier: 0.5},
+		{Mode: FeeModeFixed},
+		{Mode: FeeModeCapped},
+		{MaxFee: -1},
+	}
+	for _, s := range invalid {
+		if err := s.Validate(); err == nil {
+			t.Errorf("Validate(%+v) = nil, want error", s)
+		}
+	}
+}
+
+func TestFeeStrategyFromEnv(t *testing.T) {
+	t.Setenv("NEO_FEE_MODE", "Multiplier")
+	t.Setenv("NEO_FEE_MULTIPLIER", "1.25")
+	t.Setenv("NEO_MAX_NETWORK_FEE", "0.5")
+
+	s, err := FeeStrategyFromEnv()
+	if err != nil {
+		t.Fatalf("FeeStrategyFromEnv() error = %v", err)
+	}
+	if s.Mode != FeeModeMultiplier || s.Multiplier != 1.25 || s.MaxFee != 50_000_000 {
+		t.Fatalf("FeeStrategyFromEnv() = %+v", s)
+	}
+
+	t.Setenv("NEO_FEE_MODE", "capped")
+	t.Setenv("NEO_MAX_NETWORK_FEE", "")
+	if _, err := FeeStrategyFromEnv(); err == nil {
+		t.Fatal("capped mode without a maximum should fail")
+	}
+}
+
+func TestTxBuilderInheritsClientFeeStrategy(t *testing.T) {
+	client, _ := NewClient(Config{RPCURL: "http://example"})
+	strategy := FeeStrategy{Mode: FeeModeFixed, Fixed: 1_000_000}
+	if err := client.SetFeeStrategy(strategy); err != nil {
+		t.Fatalf("SetFeeStrategy() error = %v", err)
+	}
+	if got := NewTxBuilder(client, 894710606).feeStrat; got != strategy {
+		t.Fatalf("builder fee strategy = %+v, want %+v", got, strategy)
+	}
+}
+
+func TestActorOptionsApplyFeeStrategy(t *testing.T) {
+	client, err := NewClient(Config{RPCURL: "http://example"})
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+	halt := &result.Invoke{State: vmstate.Halt.String()}
+
+	_ = client.SetFeeStrategy(FeeStrategy{Mode: FeeModeMultiplier, Multiplier: 2})
+	tx := &transaction.Transaction{NetworkFee: 1_000_000}
+	if err := client.actorOptions().CheckerModifier(halt, tx); err != nil {
+		t.Fatalf("CheckerModifier() error = %v", err)
+	}
+	if tx.NetworkFee != 2_000_000+defaultNetworkFeeBuffer {
+		t.Fatalf("NetworkFee = %d, want the multiplied fee plus buffer", tx.NetworkFee)
+	}
+
+	// The strategy is read per transaction, so cached actors see changes.
+	opts := client.actorOptions()
+	_ = client.SetFeeStrategy(FeeStrategy{MaxFee: 500_000})
+	tx = &transaction.Transaction{NetworkFee: 1_000_000}
+	if err := opts.CheckerModifier(halt, tx); !errors.Is(err, ErrNetworkFeeTooHigh) {
+		t.Fatalf("CheckerModifier() error = %v, want ErrNetworkFeeTooHigh", err)
+	}
+	if err := opts.Modifier(tx); !errors.Is(err, ErrNetworkFeeTooHigh) {
+		t.Fatalf("Modifier() error = %v, want ErrNetworkFeeTooHigh", err)
+	}
+
+	fault := &result.Invoke{State: vmstate.Fault.String(), FaultException: "boom"}
+	if err := opts.CheckerModifier(fault, &transaction.Transaction{}); err == nil {
+		t.Fatal("a FAULTed test invocation should still be rejected")
+	}
+}
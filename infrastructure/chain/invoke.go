@@ -91,6 +91,77 @@ func (c *Client) WaitForApplicationLog(ctx context.Context, txHash string, pollI
 	}
 }
 
+// GetTransactionHeight returns the index of the block that includes txHash.
+func (c *Client) GetTransactionHeight(ctx context.Context, txHash string) (uint64, error) {
+	result, err := c.Call(ctx, "gettransactionheight", []interface{}{txHash})
+	if err != nil {
+		return 0, err
+	}
+
+	var height uint64
+	if err := json.Unmarshal(result, &height); err != nil {
+		return 0, err
+	}
+	return height, nil
+}
+
+// WaitForConfirmation polls until txHash is included in a block and has at
+// least confirmations blocks on top of it, counting the including block as the
+// first, then returns its application log. Values below 1 are treated as 1.
+//
+// A FAULTed transaction is still confirmed: check the VM state and exception
+// on the returned log's executions. The wait is bounded only by ctx.
+func (c *Client) WaitForConfirmation(ctx context.Context, txHash string, confirmations int) (*ApplicationLog, error) {
+	return c.waitForConfirmation(ctx, txHash, confirmations, DefaultPollInterval)
+}
+
+func (c *Client) waitForConfirmation(ctx context.Context, txHash string, confirmations int, pollInterval time.Duration) (*ApplicationLog, error) {
+	if confirmations < 1 {
+		confirmations = 1
+	}
+
+	var (
+		appLog    *ApplicationLog
+		txHeight  uint64
+		haveBlock bool
+	)
+	for {
+		if appLog == nil {
+			log, err := c.GetApplicationLog(ctx, txHash)
+			if err != nil && !isNotFoundError(err) {
+				return nil, err
+			}
+			appLog = log
+		}
+		if appLog != nil && confirmations == 1 {
+			return appLog, nil
+		}
+		if appLog != nil && !haveBlock {
+			height, err := c.GetTransactionHeight(ctx, txHash)
+			if err != nil && !isNotFoundError(err) {
+				return nil, err
+			}
+			txHeight, haveBlock = height, err == nil
+		}
+		if haveBlock {
+			count, err := c.GetBlockCount(ctx)
+			if err != nil {
+				return nil, err
+			}
+			// Block count is height+1, so the including block alone yields one confirmation.
+			if count >= txHeight+uint64(confirmations) {
+				return appLog, nil
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("wait for %d confirmations of %s: %w", confirmations, txHash, ctx.Err())
+		case <-time.After(pollInterval):
+		}
+	}
+}
+
 // DefaultTxWaitTimeout is the default timeout for waiting for transaction execution.
 const DefaultTxWaitTimeout = 2 * time.Minute
 
@@ -193,17 +264,17 @@ func (c *Client) InvokeFunctionWithSignerAndWait(
 		return nil, fmt.Errorf("%s simulation failed: %s", method, invokeResult.Exception)
 	}
 
-	// 2. Build and sign the transaction
+	// 2. Build, sign and broadcast, resubmitting if the node reports it expired
 	txBuilder := NewTxBuilder(c, c.networkID)
-	tx, err := txBuilder.BuildAndSignTx(ctx, invokeResult, account, signerScopes)
-	if err != nil {
-		return nil, fmt.Errorf("build transaction for %s: %w", method, err)
+	if record := txBuilder.onAttempt; record != nil {
+		txBuilder.OnSubmitAttempt(func(ctx context.Context, a SubmitAttempt) {
+			a.Contract, a.Method = contractAddress, method
+			record(ctx, a)
+		})
 	}
-
-	// 3. Broadcast the transaction
-	txHash, err := txBuilder.BroadcastTx(ctx, tx)
+	txHash, err := txBuilder.SubmitTx(ctx, invokeResult, account, signerScopes)
 	if err != nil {
-		return nil, fmt.Errorf("broadcast %s: %w", method, err)
+		return nil, fmt.Errorf("submit %s: %w", method, err)
 	}
 
 	result := &TxResult{
@@ -215,7 +286,7 @@ func (c *Client) InvokeFunctionWithSignerAndWait(
 		return result, nil
 	}
 
-	// 4. Wait for transaction confirmation
+	// 3. Wait for transaction confirmation
 	wctx, cancel := context.WithTimeout(ctx, DefaultTxWaitTimeout)
 	defer cancel()
 
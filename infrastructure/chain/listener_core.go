@@ -4,9 +4,11 @@ package chain
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/R3E-Network/neo-miniapps-platform/infrastructure/logging"
@@ -18,22 +20,31 @@ type EventListener struct {
 	client            *Client
 	chainID           string
 	contractAddresses map[string]bool // Multiple contracts to monitor
-	handlers          map[string][]EventHandler
-	anyHandlers       []EventHandler
-	txHandlers        []TxHandler
+	handlers          map[string][]*subscriber
+	anyHandlers       []*subscriber
+	txHandlers        []*subscriber
 	pollInterval      time.Duration
 	lastBlock         uint64
 	confirmations     uint64
 	running           bool
 	stopCh            chan struct{}
 	logger            *logging.Logger
-	handlerSem        chan struct{}
+
+	// Backpressure
+	maxSubscribers   int
+	subscriberBuffer int
+	handlerWorkers   int
+	subscriberSeq    int
+	droppedCalls     atomic.Uint64
 }
 
+// ErrTooManySubscribers is returned when registering a handler would exceed
+// ListenerConfig.MaxSubscribers.
+var ErrTooManySubscribers = errors.New("event subscriber limit reached")
+
 // EventHandler is a callback for contract events.
 type EventHandler func(event *ContractEvent) error
 
-// TxHandler is a callback for transaction-level events.
 // TxHandler is a callback for transaction-level events.
 type TxHandler func(event *TransactionEvent) error
 
@@ -72,11 +83,26 @@ type ListenerConfig struct {
 	StartBlock    uint64
 	Confirmations uint64
 	Logger        *logging.Logger
-	// MaxHandlerConcurrency limits concurrent handler goroutines. Use <0 for unlimited.
+	// MaxHandlerConcurrency is the number of handler calls each subscriber
+	// runs at once from its queue. Values <= 0 use DefaultHandlerConcurrency.
 	MaxHandlerConcurrency int
+	// MaxSubscribers caps the total number of registered handlers (event, any and
+	// transaction). Registrations beyond the cap return ErrTooManySubscribers.
+	// Use 0 for unlimited.
+	MaxSubscribers int
+	// SubscriberBuffer is the queue size of each subscriber. Every subscriber
+	// consumes its queue with its own MaxHandlerConcurrency workers; when a
+	// slow subscriber's queue is full, events are dropped for that subscriber
+	// only and counted in its drop counter. Defaults to DefaultSubscriberBuffer.
+	SubscriberBuffer int
 }
 
-const defaultHandlerConcurrency = 32
+// DefaultSubscriberBuffer is the default per-subscriber event queue size.
+const DefaultSubscriberBuffer = 256
+
+// DefaultHandlerConcurrency is the default number of concurrent handler
+// calls per subscriber.
+const DefaultHandlerConcurrency = 8
 
 // NewEventListener creates a new event listener.
 func NewEventListener(cfg *ListenerConfig) *EventListener {
@@ -110,57 +136,136 @@ func NewEventListener(cfg *ListenerConfig) *EventListener {
 		logger = logging.NewFromEnv("chain")
 	}
 
-	maxConc := cfg.MaxHandlerConcurrency
-	if maxConc == 0 {
-		maxConc = defaultHandlerConcurrency
+	buffer := cfg.SubscriberBuffer
+	if buffer <= 0 {
+		buffer = DefaultSubscriberBuffer
 	}
-	var handlerSem chan struct{}
-	if maxConc > 0 {
-		handlerSem = make(chan struct{}, maxConc)
+	workers := cfg.MaxHandlerConcurrency
+	if workers <= 0 {
+		workers = DefaultHandlerConcurrency
 	}
 
 	return &EventListener{
 		client:            cfg.Client,
 		chainID:           cfg.ChainID,
 		contractAddresses: contractAddresses,
-		handlers:          make(map[string][]EventHandler),
+		handlers:          make(map[string][]*subscriber),
 		pollInterval:      interval,
 		lastBlock:         cfg.StartBlock,
 		confirmations:     cfg.Confirmations,
 		stopCh:            make(chan struct{}),
 		logger:            logger,
-		handlerSem:        handlerSem,
+
+		maxSubscribers:   cfg.MaxSubscribers,
+		subscriberBuffer: buffer,
+		handlerWorkers:   workers,
 	}
 }
 
-// On registers an event handler.
-func (l *EventListener) On(eventName string, handler EventHandler) {
+// On registers an event handler. It returns ErrTooManySubscribers when the
+// listener's subscriber cap is reached.
+func (l *EventListener) On(eventName string, handler EventHandler) error {
 	if l == nil || handler == nil {
-		return
+		return nil
 	}
 	l.mu.Lock()
 	defer l.mu.Unlock()
-	l.handlers[eventName] = append(l.handlers[eventName], handler)
+	sub, err := l.subscribeLocked("event:" + eventName)
+	if err != nil {
+		return err
+	}
+	sub.onEvent = handler
+	l.handlers[eventName] = append(l.handlers[eventName], sub)
+	return nil
 }
 
-// OnAny registers a handler for all contract events.
-func (l *EventListener) OnAny(handler EventHandler) {
+// OnAny registers a handler for all contract events. It returns
+// ErrTooManySubscribers when the listener's subscriber cap is reached.
+func (l *EventListener) OnAny(handler EventHandler) error {
 	if l == nil || handler == nil {
-		return
+		return nil
 	}
 	l.mu.Lock()
 	defer l.mu.Unlock()
-	l.anyHandlers = append(l.anyHandlers, handler)
+	sub, err := l.subscribeLocked("any")
+	if err != nil {
+		return err
+	}
+	sub.onEvent = handler
+	l.anyHandlers = append(l.anyHandlers, sub)
+	return nil
 }
 
-// OnTransaction registers a transaction-level handler.
-func (l *EventListener) OnTransaction(handler TxHandler) {
-	if handler == nil {
-		return
+// OnTransaction registers a transaction-level handler. It returns
+// ErrTooManySubscribers when the listener's subscriber cap is reached.
+func (l *EventListener) OnTransaction(handler TxHandler) error {
+	if l == nil || handler == nil {
+		return nil
 	}
 	l.mu.Lock()
 	defer l.mu.Unlock()
-	l.txHandlers = append(l.txHandlers, handler)
+	sub, err := l.subscribeLocked("transaction")
+	if err != nil {
+		return err
+	}
+	sub.onTx = handler
+	l.txHandlers = append(l.txHandlers, sub)
+	return nil
+}
+
+// SubscriberCount returns the total number of registered handlers.
+func (l *EventListener) SubscriberCount() int {
+	if l == nil {
+		return 0
+	}
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	return len(l.subscribersLocked())
+}
+
+// DroppedHandlerCalls returns how many events were dropped, across all
+// subscribers, because a subscriber's queue was full or still held them when
+// the listener stopped.
+func (l *EventListener) DroppedHandlerCalls() uint64 {
+	if l == nil {
+		return 0
+	}
+	return l.droppedCalls.Load()
+}
+
+// SubscriberStats returns the queue state of every registered subscriber.
+func (l *EventListener) SubscriberStats() []SubscriberStats {
+	if l == nil {
+		return nil
+	}
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	subs := l.subscribersLocked()
+	stats := make([]SubscriberStats, 0, len(subs))
+	for _, sub := range subs {
+		stats = append(stats, sub.stats(l.lastBlock))
+	}
+	return stats
+}
+
+func (l *EventListener) subscribersLocked() []*subscriber {
+	subs := make([]*subscriber, 0, len(l.anyHandlers)+len(l.txHandlers))
+	for _, hs := range l.handlers {
+		subs = append(subs, hs...)
+	}
+	subs = append(subs, l.anyHandlers...)
+	return append(subs, l.txHandlers...)
+}
+
+// subscribeLocked creates a subscriber and starts its workers.
+func (l *EventListener) subscribeLocked(topic string) (*subscriber, error) {
+	if l.maxSubscribers > 0 && len(l.subscribersLocked()) >= l.maxSubscribers {
+		return nil, fmt.Errorf("%w (%d): %s", ErrTooManySubscribers, l.maxSubscribers, topic)
+	}
+	l.subscriberSeq++
+	sub := newSubscriber(fmt.Sprintf("%s#%d", topic, l.subscriberSeq), l.subscriberBuffer, l.handlerWorkers, l.logger)
+	sub.start(l.stopCh, &l.droppedCalls)
+	return sub, nil
 }
 
 // Start starts the event listener.
@@ -276,7 +381,7 @@ func (l *EventListener) processTransaction(
 	}
 
 	l.mu.RLock()
-	txHandlers := append([]TxHandler(nil), l.txHandlers...)
+	txHandlers := append([]*subscriber(nil), l.txHandlers...)
 	l.mu.RUnlock()
 	if len(txHandlers) > 0 {
 		contracts, parseErr := ExtractContractCallTargets(tx.Script)
@@ -295,13 +400,13 @@ func (l *EventListener) processTransaction(
 				Script:     tx.Script,
 				Contracts:  contracts,
 			}
-			for _, handler := range txHandlers {
-				h := handler
-				l.runHandler(ctx, map[string]interface{}{
+			for _, sub := range txHandlers {
+				h := sub.onTx
+				sub.deliver(blockIndex, map[string]interface{}{
 					"tx_hash": txEvent.TxHash,
 				}, "transaction handler failed", func() error {
 					return h(txEvent)
-				})
+				}, &l.droppedCalls)
 			}
 		}
 	}
@@ -343,61 +448,26 @@ func (l *EventListener) processTransaction(
 
 			// Call handlers
 			l.mu.RLock()
-			handlers := append([]EventHandler(nil), l.handlers[notif.EventName]...)
-			anyHandlers := append([]EventHandler(nil), l.anyHandlers...)
+			handlers := append([]*subscriber(nil), l.handlers[notif.EventName]...)
+			handlers = append(handlers, l.anyHandlers...)
 			l.mu.RUnlock()
 
-			for _, handler := range handlers {
-				h := handler
-				l.runHandler(ctx, map[string]interface{}{
-					"event":     event.EventName,
-					"contract":  event.Contract,
-					"tx_hash":   event.TxHash,
-					"block_idx": event.BlockIndex,
-				}, "event handler failed", func() error {
-					return h(event)
-				})
+			fields := map[string]interface{}{
+				"event":     event.EventName,
+				"contract":  event.Contract,
+				"tx_hash":   event.TxHash,
+				"block_idx": event.BlockIndex,
 			}
-
-			for _, handler := range anyHandlers {
-				h := handler
-				l.runHandler(ctx, map[string]interface{}{
-					"event":     event.EventName,
-					"contract":  event.Contract,
-					"tx_hash":   event.TxHash,
-					"block_idx": event.BlockIndex,
-				}, "event handler failed", func() error {
+			for _, sub := range handlers {
+				h := sub.onEvent
+				sub.deliver(blockIndex, fields, "event handler failed", func() error {
 					return h(event)
-				})
+				}, &l.droppedCalls)
 			}
 		}
 	}
 }
 
-func (l *EventListener) runHandler(ctx context.Context, fields map[string]interface{}, message string, fn func() error) {
-	if l == nil || fn == nil {
-		return
-	}
-	if l.handlerSem != nil {
-		select {
-		case l.handlerSem <- struct{}{}:
-		case <-ctx.Done():
-			return
-		case <-l.stopCh:
-			return
-		}
-	}
-
-	go func() {
-		if l.handlerSem != nil {
-			defer func() { <-l.handlerSem }()
-		}
-		if err := fn(); err != nil {
-			l.logger.WithFields(fields).WithError(err).Warn(message)
-		}
-	}()
-}
-
 func normalizeContractAddress(value string) string {
 	trimmed := strings.TrimSpace(value)
 	trimmed = strings.TrimPrefix(trimmed, "0x")
@@ -0,0 +1,181 @@
+package chain
+
+import (
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestEventListenerMaxSubscribers(t *testing.T) {
+	l := NewEventListener(&ListenerConfig{MaxSubscribers: 2})
+	defer l.Stop()
+	noop := func(*ContractEvent) error { return nil }
+
+	if err := l.On("A", noop); err != nil {
+		t.Fatalf("On(A) error = %v", err)
+	}
+	if err := l.OnAny(noop); err != nil {
+		t.Fatalf("OnAny() error = %v", err)
+	}
+	if err := l.On("B", noop); !errors.Is(err, ErrTooManySubscribers) {
+		t.Fatalf("On(B) error = %v, want ErrTooManySubscribers", err)
+	}
+	if err := l.OnTransaction(func(*TransactionEvent) error { return nil }); !errors.Is(err, ErrTooManySubscribers) {
+		t.Fatalf("OnTransaction() error = %v, want ErrTooManySubscribers", err)
+	}
+
+	if got := l.SubscriberCount(); got != 2 {
+		t.Fatalf("SubscriberCount() = %d, want 2", got)
+	}
+	if len(l.handlers["B"]) != 0 || len(l.txHandlers) != 0 {
+		t.Fatal("registrations beyond the limit should be rejected")
+	}
+}
+
+func TestEventListenerSlowSubscriberDoesNotBlockOthers(t *testing.T) {
+	l := NewEventListener(&ListenerConfig{SubscriberBuffer: 1, MaxHandlerConcurrency: 1})
+	defer l.Stop()
+
+	release := make(chan struct{})
+	defer close(release)
+	started := make(chan struct{}, 1)
+	if err := l.On("Tick", func(*ContractEvent) error {
+		select {
+		case started <- struct{}{}:
+		default:
+		}
+		<-release
+		return nil
+	}); err != nil {
+		t.Fatalf("On(slow) error = %v", err)
+	}
+	var fast atomic.Int32
+	if err := l.OnAny(func(*ContractEvent) error {
+		fast.Add(1)
+		return nil
+	}); err != nil {
+		t.Fatalf("OnAny(fast) error = %v", err)
+	}
+
+	slow := l.handlers["Tick"][0]
+	fastSub := l.anyHandlers[0]
+	for i := 0; i < 5; i++ {
+		event := &ContractEvent{EventName: "Tick", BlockIndex: uint64(i + 1)}
+		for _, sub := range []*subscriber{slow, fastSub} {
+			h := sub.onEvent
+			sub.deliver(event.BlockIndex, nil, "test", func() error { return h(event) }, &l.droppedCalls)
+		}
+		if i == 0 {
+			<-started
+		}
+		// Let the fast subscriber drain its one-slot queue.
+		deadline := time.Now().Add(time.Second)
+		for fast.Load() < int32(i+1) && time.Now().Before(deadline) {
+			time.Sleep(time.Millisecond)
+		}
+	}
+
+	if got := fast.Load(); got != 5 {
+		t.Fatalf("fast subscriber handled %d events, want 5", got)
+	}
+
+	stats := map[string]SubscriberStats{}
+	for _, s := range l.SubscriberStats() {
+		stats[s.Name] = s
+	}
+	// The slow subscriber holds one event in its handler and one in its
+	// queue; the other three are dropped for it alone.
+	if got := stats[slow.name].Dropped; got != 3 {
+		t.Fatalf("slow subscriber dropped %d events, want 3", got)
+	}
+	if got := stats[slow.name].Queued; got != 1 {
+		t.Fatalf("slow subscriber queued = %d, want 1", got)
+	}
+	if got := stats[fastSub.name].Dropped; got != 0 {
+		t.Fatalf("fast subscriber dropped %d events, want 0", got)
+	}
+	if got := l.DroppedHandlerCalls(); got != 3 {
+		t.Fatalf("DroppedHandlerCalls() = %d, want 3", got)
+	}
+}
+
+func TestSubscriberRunsHandlersConcurrently(t *testing.T) {
+	l := NewEventListener(&ListenerConfig{SubscriberBuffer: 8, MaxHandlerConcurrency: 3})
+	defer l.Stop()
+
+	release := make(chan struct{})
+	defer close(release)
+	var running, peak atomic.Int32
+	if err := l.On("Tick", func(*ContractEvent) error {
+		n := running.Add(1)
+		for {
+			p := peak.Load()
+			if n <= p || peak.CompareAndSwap(p, n) {
+				break
+			}
+		}
+		<-release
+		running.Add(-1)
+		return nil
+	}); err != nil {
+		t.Fatalf("On() error = %v", err)
+	}
+
+	sub := l.handlers["Tick"][0]
+	for i := 0; i < 5; i++ {
+		event := &ContractEvent{EventName: "Tick", BlockIndex: uint64(i + 1)}
+		sub.deliver(event.BlockIndex, nil, "test", func() error { return sub.onEvent(event) }, &l.droppedCalls)
+	}
+
+	deadline := time.Now().Add(time.Second)
+	for peak.Load() < 3 && time.Now().Before(deadline) {
+		time.Sleep(time.Millisecond)
+	}
+	time.Sleep(20 * time.Millisecond)
+	if got := peak.Load(); got != 3 {
+		t.Fatalf("concurrent handler calls = %d, want 3", got)
+	}
+}
+
+func TestSubscriberCountsQueuedEventsOnStop(t *testing.T) {
+	l := NewEventListener(&ListenerConfig{SubscriberBuffer: 4, MaxHandlerConcurrency: 1})
+
+	release := make(chan struct{})
+	started := make(chan struct{}, 1)
+	if err := l.On("Tick", func(*ContractEvent) error {
+		select {
+		case started <- struct{}{}:
+		default:
+		}
+		<-release
+		return nil
+	}); err != nil {
+		t.Fatalf("On() error = %v", err)
+	}
+
+	sub := l.handlers["Tick"][0]
+	for i := 0; i < 4; i++ {
+		event := &ContractEvent{EventName: "Tick", BlockIndex: uint64(i + 1)}
+		sub.deliver(event.BlockIndex, nil, "test", func() error { return sub.onEvent(event) }, &l.droppedCalls)
+		if i == 0 {
+			<-started
+		}
+	}
+
+	// One event is in the handler and three are queued when the listener
+	// stops. The listener was never started, so close stopCh as Stop would.
+	close(l.stopCh)
+	close(release)
+
+	deadline := time.Now().Add(time.Second)
+	for l.DroppedHandlerCalls() < 3 && time.Now().Before(deadline) {
+		time.Sleep(time.Millisecond)
+	}
+	if got := l.DroppedHandlerCalls(); got != 3 {
+		t.Fatalf("DroppedHandlerCalls() = %d, want 3 queued events counted on stop", got)
+	}
+	if got := len(sub.queue); got != 0 {
+		t.Fatalf("queue length = %d, want drained", got)
+	}
+}
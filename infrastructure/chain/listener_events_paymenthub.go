@@ -3,6 +3,7 @@ package chain
 import (
 	"fmt"
 
+	"github.com/R3E-Network/neo-miniapps-platform/infrastructure/amount"
 	"github.com/R3E-Network/neo-miniapps-platform/infrastructure/crypto"
 )
 
@@ -12,7 +13,7 @@ type PaymentReceivedEvent struct {
 	PaymentID     string
 	AppID         string
 	SenderAddress string
-	Amount        string
+	Amount        amount.Amount
 	Memo          string
 }
 
@@ -43,7 +44,7 @@ func ParsePaymentReceivedEvent(event *ContractEvent) (*PaymentReceivedEvent, err
 	}
 	senderAddress := crypto.ScriptHashToAddress(senderBytes)
 
-	amount, err := ParseInteger(event.State[3])
+	value, err := ParseInteger(event.State[3])
 	if err != nil {
 		return nil, fmt.Errorf("parse amount: %w", err)
 	}
@@ -57,7 +58,7 @@ func ParsePaymentReceivedEvent(event *ContractEvent) (*PaymentReceivedEvent, err
 		PaymentID:     paymentID.String(),
 		AppID:         appID,
 		SenderAddress: senderAddress,
-		Amount:        amount.String(),
+		Amount:        amount.FromBig(value),
 		Memo:          memo,
 	}, nil
 }
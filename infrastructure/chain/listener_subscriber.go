@@ -0,0 +1,166 @@
+package chain
+
+import (
+	"sync/atomic"
+
+	"github.com/R3E-Network/neo-miniapps-platform/infrastructure/logging"
+	slmetrics "github.com/R3E-Network/neo-miniapps-platform/infrastructure/metrics"
+)
+
+// SubscriberStats describes the delivery state of one event subscriber.
+type SubscriberStats struct {
+	// Name identifies the subscriber, e.g. "event:ServiceRequested#1".
+	Name string `json:"name"`
+	// Queued is the number of events waiting to be handled.
+	Queued int `json:"queued"`
+	// Dropped is the number of events dropped because the queue was full or
+	// still held them when the listener stopped.
+	Dropped uint64 `json:"dropped"`
+	// LastBlock is the block of the last event the subscriber handled.
+	LastBlock uint64 `json:"last_block"`
+	// LagBlocks is how far LastBlock trails the listener's processed block.
+	LagBlocks uint64 `json:"lag_blocks"`
+}
+
+// delivery is one queued handler call.
+type delivery struct {
+	block   uint64
+	fields  map[string]interface{}
+	message string
+	fn      func() error
+}
+
+// subscriber is a registered handler with its own queue and worker pool, so
+// a slow handler only delays (and eventually drops) its own events.
+type subscriber struct {
+	name    string
+	onEvent EventHandler
+	onTx    TxHandler
+
+	queue     chan delivery
+	workers   int
+	logger    *logging.Logger
+	dropped   atomic.Uint64
+	lastBlock atomic.Uint64
+}
+
+func newSubscriber(name string, buffer, workers int, logger *logging.Logger) *subscriber {
+	if workers < 1 {
+		workers = 1
+	}
+	return &subscriber{
+		name:    name,
+		queue:   make(chan delivery, buffer),
+		workers: workers,
+		logger:  logger,
+	}
+}
+
+// deliver queues a handler call without blocking. When the queue is full the
+// call is dropped and counted for this subscriber and in total.
+func (s *subscriber) deliver(block uint64, fields map[string]interface{}, message string, fn func() error, total *atomic.Uint64) {
+	select {
+	case s.queue <- delivery{block: block, fields: fields, message: message, fn: fn}:
+		s.reportLag()
+	default:
+		s.recordDrops(1, total)
+		s.logger.WithFields(fields).WithField("subscriber", s.name).Warn("subscriber queue full; dropping event")
+	}
+}
+
+func (s *subscriber) recordDrops(n uint64, total *atomic.Uint64) {
+	s.dropped.Add(n)
+	total.Add(n)
+	if slmetrics.Enabled() {
+		for i := uint64(0); i < n; i++ {
+			slmetrics.Global().RecordEventSubscriberDrop(s.name)
+		}
+	}
+}
+
+// start launches the subscriber's workers. Up to s.workers handler calls run
+// at once, so one slow call does not hold up the rest of the queue.
+func (s *subscriber) start(stopCh <-chan struct{}, total *atomic.Uint64) {
+	for i := 0; i < s.workers; i++ {
+		go s.run(stopCh, total)
+	}
+}
+
+// run handles queued calls until stopCh is closed. Calls still queued at that
+// point are not run; they are counted as dropped.
+func (s *subscriber) run(stopCh <-chan struct{}, total *atomic.Uint64) {
+	for {
+		// Prefer stopping over a ready queue, so nothing new starts once the
+		// listener is stopped.
+		select {
+		case <-stopCh:
+			s.discardQueued(total)
+			return
+		default:
+		}
+		select {
+		case <-stopCh:
+			s.discardQueued(total)
+			return
+		case d := <-s.queue:
+			if err := d.fn(); err != nil {
+				s.logger.WithFields(d.fields).WithError(err).Warn(d.message)
+			}
+			s.markHandled(d.block)
+			s.reportLag()
+		}
+	}
+}
+
+// discardQueued empties the queue on shutdown, counting each call as dropped.
+func (s *subscriber) discardQueued(total *atomic.Uint64) {
+	var n uint64
+drain:
+	for {
+		select {
+		case <-s.queue:
+			n++
+		default:
+			break drain
+		}
+	}
+	if n == 0 {
+		return
+	}
+	s.recordDrops(n, total)
+	s.reportLag()
+	s.logger.WithFields(map[string]interface{}{
+		"subscriber": s.name,
+		"dropped":    n,
+	}).Warn("listener stopped; dropping queued events")
+}
+
+// markHandled advances lastBlock. Workers finish out of order, so it only
+// moves forward.
+func (s *subscriber) markHandled(block uint64) {
+	for {
+		current := s.lastBlock.Load()
+		if block <= current || s.lastBlock.CompareAndSwap(current, block) {
+			return
+		}
+	}
+}
+
+func (s *subscriber) reportLag() {
+	if slmetrics.Enabled() {
+		slmetrics.Global().SetEventSubscriberLag(s.name, len(s.queue))
+	}
+}
+
+func (s *subscriber) stats(listenerBlock uint64) SubscriberStats {
+	stats := SubscriberStats{
+		Name:      s.name,
+		Queued:    len(s.queue),
+		Dropped:   s.dropped.Load(),
+		LastBlock: s.lastBlock.Load(),
+	}
+	if stats.Queued > 0 && listenerBlock > stats.LastBlock {
+		stats.LagBlocks = listenerBlock - stats.LastBlock
+	}
+	return stats
+}
@@ -0,0 +1,88 @@
+package chain
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// =============================================================================
+// Pooled Client - Transparent RPC Failover
+// =============================================================================
+
+// NewPooledClient creates a Client that spreads calls over several RPC
+// endpoints, failing over when an endpoint errors or times out. cfg supplies
+// the network, timeout and HTTP client; its RPCURL is ignored.
+//
+// With a single endpoint this is equivalent to NewClient. Call Pool().Start
+// to run background health checks so failed endpoints can recover.
+func NewPooledClient(endpoints []string, cfg Config) (*Client, error) {
+	if len(endpoints) == 0 {
+		return nil, fmt.Errorf("RPC URL required")
+	}
+	if len(endpoints) == 1 {
+		cfg.RPCURL = endpoints[0]
+		return NewClient(cfg)
+	}
+
+	poolCfg := DefaultRPCPoolConfig()
+	poolCfg.Endpoints = endpoints
+	poolCfg.HTTPClient = cfg.HTTPClient
+	pool, err := NewRPCPool(poolCfg)
+	if err != nil {
+		return nil, err
+	}
+
+	cfg.RPCURL = pool.endpoints[0].URL
+	client, err := NewClient(cfg)
+	if err != nil {
+		return nil, err
+	}
+	client.pool = pool
+	return client, nil
+}
+
+// Pool returns the client's endpoint pool, or nil for a single-endpoint client.
+func (c *Client) Pool() *RPCPool {
+	if c == nil {
+		return nil
+	}
+	return c.pool
+}
+
+// callWithFailover tries each pool endpoint in turn until one answers.
+// JSON-RPC errors come from a live node, so they are returned to the caller
+// without failing over or marking the endpoint unhealthy.
+func (c *Client) callWithFailover(ctx context.Context, body []byte) (json.RawMessage, error) {
+	var result json.RawMessage
+	var rpcErr error
+	err := c.pool.ExecuteWithFailover(ctx, len(c.pool.endpoints)-1, func(url string) error {
+		res, callErr := c.callEndpoint(ctx, url, body)
+		var nodeErr *RPCError
+		if errors.As(callErr, &nodeErr) {
+			result, rpcErr = nil, callErr
+			return nil
+		}
+		if callErr != nil {
+			return callErr
+		}
+		result, rpcErr = res, nil
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, rpcErr
+}
+
+// endpointURL returns the endpoint for long-lived connections such as actor
+// RPC clients: the best pool endpoint when pooled, else the configured URL.
+func (c *Client) endpointURL() string {
+	if c.pool != nil {
+		if ep, err := c.pool.GetBestEndpoint(); err == nil && ep != nil {
+			return ep.URL
+		}
+	}
+	return c.rpcURL
+}
@@ -0,0 +1,94 @@
+package chain
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func newPooledTestClient(t *testing.T, handler func(host string, req RPCRequest) (*http.Response, error)) *Client {
+	t.Helper()
+	httpClient := &http.Client{Transport: roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+		var req RPCRequest
+		_ = json.NewDecoder(r.Body).Decode(&req)
+		return handler(r.URL.Host, req)
+	})}
+	client, err := NewPooledClient([]string{"http://primary.example", "http://backup.example"}, Config{HTTPClient: httpClient})
+	if err != nil {
+		t.Fatalf("NewPooledClient() error = %v", err)
+	}
+	if client.Pool() == nil {
+		t.Fatal("expected a pool for multiple endpoints")
+	}
+	return client
+}
+
+func TestPooledClientFailsOverOnEndpointError(t *testing.T) {
+	var hosts []string
+	client := newPooledTestClient(t, func(host string, req RPCRequest) (*http.Response, error) {
+		hosts = append(hosts, host)
+		if host == "primary.example" {
+			return &http.Response{
+				StatusCode: http.StatusBadGateway,
+				Header:     make(http.Header),
+				Body:       io.NopCloser(strings.NewReader("bad gateway")),
+			}, nil
+		}
+		payload, _ := json.Marshal(RPCResponse{JSONRPC: "2.0", ID: req.ID, Result: json.RawMessage(`12345`)})
+		return newResponse(payload), nil
+	})
+
+	count, err := client.GetBlockCount(context.Background())
+	if err != nil {
+		t.Fatalf("GetBlockCount() error = %v", err)
+	}
+	if count != 12345 {
+		t.Fatalf("GetBlockCount() = %d, want 12345", count)
+	}
+	if len(hosts) != 2 || hosts[0] != "primary.example" || hosts[1] != "backup.example" {
+		t.Fatalf("endpoints tried = %v, want primary then backup", hosts)
+	}
+	if eps := client.Pool().GetEndpoints(); eps[0].ConsecutiveFails != 1 {
+		t.Fatalf("primary consecutive fails = %d, want 1", eps[0].ConsecutiveFails)
+	}
+}
+
+func TestPooledClientDoesNotFailOverOnRPCError(t *testing.T) {
+	calls := 0
+	client := newPooledTestClient(t, func(host string, req RPCRequest) (*http.Response, error) {
+		calls++
+		payload, _ := json.Marshal(RPCResponse{JSONRPC: "2.0", ID: req.ID, Error: &RPCError{Code: -100, Message: "Unknown transaction"}})
+		return newResponse(payload), nil
+	})
+
+	_, err := client.Call(context.Background(), "getrawtransaction", []interface{}{"0x00"})
+	var rpcErr *RPCError
+	if !errors.As(err, &rpcErr) || rpcErr.Code != -100 {
+		t.Fatalf("Call() error = %v, want RPC error -100", err)
+	}
+	if calls != 1 {
+		t.Fatalf("calls = %d, want 1 (RPC errors must not fail over)", calls)
+	}
+	for _, ep := range client.Pool().GetEndpoints() {
+		if ep.ConsecutiveFails != 0 {
+			t.Fatalf("endpoint %s marked failing after an RPC error", ep.URL)
+		}
+	}
+}
+
+func TestNewPooledClientSingleEndpoint(t *testing.T) {
+	client, err := NewPooledClient([]string{"http://localhost:10332"}, Config{})
+	if err != nil {
+		t.Fatalf("NewPooledClient() error = %v", err)
+	}
+	if client.Pool() != nil {
+		t.Fatal("single endpoint should not create a pool")
+	}
+	if _, err := NewPooledClient(nil, Config{}); err == nil {
+		t.Fatal("expected error for no endpoints")
+	}
+}
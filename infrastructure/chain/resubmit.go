@@ -0,0 +1,214 @@
+package chain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/nspcc-dev/neo-go/pkg/core/transaction"
+	"github.com/nspcc-dev/neo-go/pkg/util"
+)
+
+// =============================================================================
+// Submission With Resubmission
+// =============================================================================
+
+// DefaultMaxResubmits is how many times SubmitTx rebuilds and resubmits a
+// transaction the node rejected as expired.
+const DefaultMaxResubmits = 2
+
+// ErrTxPermanent marks a submission failure that resubmitting will not fix,
+// either because the node rejected the transaction for a non-transient reason
+// or because the resubmission budget ran out. Callers that keep a dead-letter
+// queue should park these instead of retrying.
+var ErrTxPermanent = errors.New("transaction permanently rejected")
+
+// Neo N3 sendrawtransaction error codes (neo-go neorpc).
+const (
+	rpcErrAlreadyExists      = -501
+	rpcErrAlreadyInPool      = -503
+	rpcErrExpiredTransaction = -510
+)
+
+// IsTxAlreadyKnown reports whether err is a node rejecting a transaction it
+// already holds in its mempool or ledger. The earlier submission stands, so
+// the transaction hash is still valid.
+func IsTxAlreadyKnown(err error) bool {
+	var rpcErr *RPCError
+	if !errors.As(err, &rpcErr) {
+		return false
+	}
+	if rpcErr.Code == rpcErrAlreadyExists || rpcErr.Code == rpcErrAlreadyInPool {
+		return true
+	}
+	msg := strings.ToLower(rpcErr.Message + " " + rpcErr.Data)
+	return strings.Contains(msg, "already exists") || strings.Contains(msg, "already in")
+}
+
+// IsTxExpired reports whether err is a node rejecting a transaction whose
+// ValidUntilBlock is already behind the chain, typically because it was built
+// from a stale cached height.
+func IsTxExpired(err error) bool {
+	var rpcErr *RPCError
+	if !errors.As(err, &rpcErr) {
+		return false
+	}
+	if rpcErr.Code == rpcErrExpiredTransaction {
+		return true
+	}
+	msg := strings.ToLower(rpcErr.Message + " " + rpcErr.Data)
+	return strings.Contains(msg, "expired") || strings.Contains(msg, "validuntilblock")
+}
+
+// SubmitAttempt describes one build-and-broadcast attempt made by SubmitTx.
+type SubmitAttempt struct {
+	Attempt         int // 1-based
+	TxHash          util.Uint256
+	ValidUntilBlock uint32
+	Nonce           uint32
+	Err             error // nil when the node accepted (or already had) the tx
+	// Permanent is set on the final attempt when SubmitTx gives up with
+	// ErrTxPermanent, so recorders can dead-letter the transaction.
+	Permanent bool
+
+	// Contract and Method are filled in by InvokeFunctionWithSignerAndWait;
+	// they are empty for direct SubmitTx calls.
+	Contract string
+	Method   string
+}
+
+// SetMaxResubmits sets how many times SubmitTx resubmits after an expiry
+// rejection. 0 disables resubmission.
+func (b *TxBuilder) SetMaxResubmits(n int) {
+	if n < 0 {
+		n = 0
+	}
+	b.maxResubmits = n
+}
+
+// OnSubmitAttempt registers fn to be called after every SubmitTx attempt, so
+// callers can persist the attempt history. It replaces any recorder inherited
+// from the client.
+func (b *TxBuilder) OnSubmitAttempt(fn func(context.Context, SubmitAttempt)) {
+	b.onAttempt = fn
+}
+
+// SubmitTx builds, signs and broadcasts a transaction from invokeResult.
+//
+// A node answering "already exists" / "already in pool" means an earlier
+// broadcast of the same transaction landed, so the hash is returned as a
+// success. An expiry rejection drops the cached chain height and rebuilds the
+// transaction with a fresh ValidUntilBlock and nonce, up to the builder's
+// resubmission limit. Any other node rejection (*RPCError), or running out of
+// resubmissions, returns an error wrapping ErrTxPermanent. Transport errors
+// are returned as-is, since the transaction may still be accepted on retry.
+func (b *TxBuilder) SubmitTx(
+	ctx context.Context,
+	invokeResult *InvokeResult,
+	account TxSigner,
+	signerScopes transaction.WitnessScope,
+) (util.Uint256, error) {
+	for attempt := 1; ; attempt++ {
+		tx, err := b.BuildAndSignTx(ctx, invokeResult, account, signerScopes)
+		if err != nil {
+			return util.Uint256{}, fmt.Errorf("build transaction: %w", err)
+		}
+
+		txHash, err := b.BroadcastTx(ctx, tx)
+		if err != nil && IsTxAlreadyKnown(err) {
+			txHash, err = tx.Hash(), nil
+		}
+		a := SubmitAttempt{
+			Attempt:         attempt,
+			TxHash:          tx.Hash(),
+			ValidUntilBlock: tx.ValidUntilBlock,
+			Nonce:           tx.Nonce,
+			Err:             err,
+		}
+		if err == nil {
+			b.recordAttempt(ctx, a)
+			return txHash, nil
+		}
+
+		err = b.classifySubmitError(ctx, err, attempt)
+		a.Permanent = errors.Is(err, ErrTxPermanent)
+		b.recordAttempt(ctx, a)
+		if err != nil {
+			return util.Uint256{}, err
+		}
+		b.txCtx.InvalidateHeight()
+	}
+}
+
+// classifySubmitError returns nil when a rejected attempt should be rebuilt
+// and resubmitted, and otherwise the error SubmitTx returns.
+func (b *TxBuilder) classifySubmitError(ctx context.Context, err error, attempt int) error {
+	if ctx.Err() != nil {
+		return err
+	}
+	// Only a node's verdict on the transaction is permanent. Transport
+	// and HTTP failures say nothing about it and are worth retrying.
+	var rpcErr *RPCError
+	if !errors.As(err, &rpcErr) {
+		return err
+	}
+	if !IsTxExpired(err) {
+		return fmt.Errorf("%w: %w", ErrTxPermanent, err)
+	}
+	if attempt > b.maxResubmits {
+		return fmt.Errorf("%w after %d attempts: %w", ErrTxPermanent, attempt, err)
+	}
+	return nil
+}
+
+func (b *TxBuilder) recordAttempt(ctx context.Context, a SubmitAttempt) {
+	if a.Err != nil {
+		slog.Warn("transaction submission rejected",
+			"attempt", a.Attempt, "tx_hash", "0x"+a.TxHash.StringLE(),
+			"valid_until_block", a.ValidUntilBlock, "error", a.Err)
+	}
+	if b.onAttempt != nil {
+		b.onAttempt(ctx, a)
+	}
+}
+
+// SetMaxResubmits sets the resubmission limit for TxBuilders created for this
+// client afterwards. The default is DefaultMaxResubmits.
+func (c *Client) SetMaxResubmits(n int) {
+	if n < 0 {
+		n = 0
+	}
+	c.txCtxMu.Lock()
+	c.maxResubmits = n
+	c.txCtxMu.Unlock()
+}
+
+// OnSubmitAttempt sets the attempt recorder inherited by TxBuilders created
+// for this client afterwards, including the one InvokeFunctionWithSignerAndWait
+// uses.
+func (c *Client) OnSubmitAttempt(fn func(context.Context, SubmitAttempt)) {
+	c.txCtxMu.Lock()
+	c.onAttempt = fn
+	c.txCtxMu.Unlock()
+}
+
+func (c *Client) submitAttemptRecorder() func(context.Context, SubmitAttempt) {
+	if c == nil {
+		return nil
+	}
+	c.txCtxMu.Lock()
+	defer c.txCtxMu.Unlock()
+	return c.onAttempt
+}
+
+// MaxResubmits returns the client's resubmission limit.
+func (c *Client) MaxResubmits() int {
+	if c == nil {
+		return DefaultMaxResubmits
+	}
+	c.txCtxMu.Lock()
+	defer c.txCtxMu.Unlock()
+	return c.maxResubmits
+}
@@ -0,0 +1,177 @@
+package chain
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/nspcc-dev/neo-go/pkg/core/transaction"
+)
+
+// submitTestClient returns a client whose node reports the given block heights
+// in turn and answers sendrawtransaction with the given errors in turn (nil for
+// success).
+func submitTestClient(t *testing.T, heights []int, sendErrs []*RPCError) (*Client, *int) {
+	t.Helper()
+	client, err := NewClient(Config{RPCURL: "http://example"})
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+
+	sends := 0
+	heightCalls := 0
+	client.httpClient.Transport = roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+		var req RPCRequest
+		_ = json.NewDecoder(r.Body).Decode(&req)
+
+		resp := RPCResponse{JSONRPC: "2.0", ID: req.ID}
+		switch req.Method {
+		case "getblockcount":
+			h := heights[min(heightCalls, len(heights)-1)]
+			heightCalls++
+			resp.Result = json.RawMessage(fmt.Sprint(h))
+		case "calculatenetworkfee":
+			resp.Result = json.RawMessage(`{"networkfee":"100000"}`)
+		case "sendrawtransaction":
+			if sends < len(sendErrs) && sendErrs[sends] != nil {
+				resp.Error = sendErrs[sends]
+			} else {
+				resp.Result = json.RawMessage(`true`)
+			}
+			sends++
+		default:
+			t.Errorf("unexpected RPC method %q", req.Method)
+		}
+		payload, _ := json.Marshal(resp)
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(bytes.NewReader(payload)),
+		}, nil
+	})
+	return client, &sends
+}
+
+func submitTestInvoke() *InvokeResult {
+	return &InvokeResult{Script: "EQ==", State: "HALT", GasConsumed: "1000000"}
+}
+
+func TestSubmitTxResubmitsExpiredWithFreshValidUntil(t *testing.T) {
+	client, sends := submitTestClient(t, []int{1000, 1200},
+		[]*RPCError{{Code: rpcErrExpiredTransaction, Message: "Expired transaction"}})
+	account, err := AccountFromPrivateKey("0000000000000000000000000000000000000000000000000000000000000001")
+	if err != nil {
+		t.Fatalf("AccountFromPrivateKey() error = %v", err)
+	}
+
+	builder := NewTxBuilder(client, 894710606)
+	var attempts []SubmitAttempt
+	builder.OnSubmitAttempt(func(_ context.Context, a SubmitAttempt) { attempts = append(attempts, a) })
+
+	txHash, err := builder.SubmitTx(context.Background(), submitTestInvoke(), account, transaction.CalledByEntry)
+	if err != nil {
+		t.Fatalf("SubmitTx() error = %v", err)
+	}
+	if *sends != 2 || len(attempts) != 2 {
+		t.Fatalf("sends = %d, attempts = %d, want 2 each", *sends, len(attempts))
+	}
+	if !IsTxExpired(attempts[0].Err) || attempts[1].Err != nil {
+		t.Fatalf("attempt errors = %v, %v", attempts[0].Err, attempts[1].Err)
+	}
+	if attempts[0].ValidUntilBlock != 1000+DefaultValidUntilBlocks || attempts[1].ValidUntilBlock != 1200+DefaultValidUntilBlocks {
+		t.Fatalf("ValidUntilBlock = %d then %d, want it refreshed", attempts[0].ValidUntilBlock, attempts[1].ValidUntilBlock)
+	}
+	if attempts[0].Nonce == attempts[1].Nonce {
+		t.Fatal("resubmission should use a new nonce")
+	}
+	if txHash != attempts[1].TxHash {
+		t.Fatalf("txHash = %s, want the resubmitted hash %s", txHash.StringLE(), attempts[1].TxHash.StringLE())
+	}
+}
+
+func TestSubmitTxAlreadyKnownIsSuccess(t *testing.T) {
+	client, sends := submitTestClient(t, []int{1000},
+		[]*RPCError{{Code: rpcErrAlreadyInPool, Message: "Already in pool"}})
+	account, _ := AccountFromPrivateKey("0000000000000000000000000000000000000000000000000000000000000001")
+
+	if _, err := NewTxBuilder(client, 894710606).SubmitTx(context.Background(), submitTestInvoke(), account, transaction.CalledByEntry); err != nil {
+		t.Fatalf("SubmitTx() error = %v", err)
+	}
+	if *sends != 1 {
+		t.Fatalf("sends = %d, want 1", *sends)
+	}
+}
+
+func TestSubmitTxPermanentFailures(t *testing.T) {
+	account, _ := AccountFromPrivateKey("0000000000000000000000000000000000000000000000000000000000000001")
+	expired := &RPCError{Code: rpcErrExpiredTransaction, Message: "Expired transaction"}
+
+	t.Run("non-transient rejection", func(t *testing.T) {
+		client, sends := submitTestClient(t, []int{1000},
+			[]*RPCError{{Code: -511, Message: "Insufficient funds"}})
+		var attempts []SubmitAttempt
+		client.OnSubmitAttempt(func(_ context.Context, a SubmitAttempt) { attempts = append(attempts, a) })
+		_, err := NewTxBuilder(client, 894710606).SubmitTx(context.Background(), submitTestInvoke(), account, transaction.CalledByEntry)
+		if !errors.Is(err, ErrTxPermanent) {
+			t.Fatalf("err = %v, want ErrTxPermanent", err)
+		}
+		if *sends != 1 {
+			t.Fatalf("sends = %d, want no resubmission", *sends)
+		}
+		if len(attempts) != 1 || !attempts[0].Permanent {
+			t.Fatalf("attempts = %+v, want one permanent attempt from the client recorder", attempts)
+		}
+	})
+
+	t.Run("resubmissions exhausted", func(t *testing.T) {
+		client, sends := submitTestClient(t, []int{1000}, []*RPCError{expired, expired, expired})
+		client.SetMaxResubmits(1)
+		_, err := NewTxBuilder(client, 894710606).SubmitTx(context.Background(), submitTestInvoke(), account, transaction.CalledByEntry)
+		if !errors.Is(err, ErrTxPermanent) || !IsTxExpired(err) {
+			t.Fatalf("err = %v, want permanent expiry", err)
+		}
+		if *sends != 2 {
+			t.Fatalf("sends = %d, want 2", *sends)
+		}
+	})
+}
+
+func TestSubmitTxTransportErrorIsRetryable(t *testing.T) {
+	client, sends := submitTestClient(t, []int{1000}, nil)
+	node := client.httpClient.Transport
+	client.httpClient.Transport = roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+		body, _ := io.ReadAll(r.Body)
+		if bytes.Contains(body, []byte("sendrawtransaction")) {
+			return nil, errors.New("connection reset by peer")
+		}
+		r.Body = io.NopCloser(bytes.NewReader(body))
+		return node.RoundTrip(r)
+	})
+	account, _ := AccountFromPrivateKey("0000000000000000000000000000000000000000000000000000000000000001")
+
+	_, err := NewTxBuilder(client, 894710606).SubmitTx(context.Background(), submitTestInvoke(), account, transaction.CalledByEntry)
+	if err == nil || errors.Is(err, ErrTxPermanent) {
+		t.Fatalf("err = %v, want a retryable transport error", err)
+	}
+	if *sends != 0 {
+		t.Fatalf("sends = %d, want the broadcast to fail before reaching the node", *sends)
+	}
+}
+
+func TestIsTxAlreadyKnownAndExpired(t *testing.T) {
+	wrapped := fmt.Errorf("broadcast transaction: %w", &RPCError{Code: -500, Message: "Transaction already exists"})
+	if !IsTxAlreadyKnown(wrapped) || IsTxExpired(wrapped) {
+		t.Fatal("expected already-known by message")
+	}
+	if IsTxAlreadyKnown(errors.New("already exists")) {
+		t.Fatal("plain errors are not node rejections")
+	}
+	if !IsTxExpired(&RPCError{Code: -500, Message: "Invalid transaction", Data: "ValidUntilBlock is in the past"}) {
+		t.Fatal("expected expiry by data")
+	}
+}
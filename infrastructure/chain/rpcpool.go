@@ -336,6 +336,7 @@ func (p *RPCPool) checkEndpoint(ctx context.Context, ep *RPCEndpoint) {
 
 // ExecuteWithFailover executes a function with automatic failover on failure.
 // The function receives the endpoint URL and should return an error if failover is needed.
+// Once ctx is done, ctx.Err() is returned as-is and the endpoint is not marked unhealthy.
 func (p *RPCPool) ExecuteWithFailover(ctx context.Context, maxRetries int, fn func(url string) error) error {
 	var lastErr error
 
@@ -366,15 +367,14 @@ func (p *RPCPool) ExecuteWithFailover(ctx context.Context, maxRetries int, fn fu
 			return nil
 		}
 
+		// A call cut short by the caller's context says nothing about the
+		// endpoint; do not mark it unhealthy or fail over.
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return ctxErr
+		}
+
 		lastErr = err
 		p.MarkUnhealthy(ep.URL)
-
-		// Check if context is canceled.
-		select {
-		case <-ctx.Done():
-			return ctx.Err()
-		default:
-		}
 	}
 
 	return fmt.Errorf("all retries exhausted: %w", lastErr)
@@ -252,6 +252,36 @@ func TestRPCPoolExecuteWithFailoverAllFail(t *testing.T) {
 	}
 }
 
+func TestRPCPoolExecuteWithFailoverCanceled(t *testing.T) {
+	pool, err := NewRPCPool(&RPCPoolConfig{
+		Endpoints:           []string{"http://node1:10332", "http://node2:10332"},
+		MaxConsecutiveFails: 1,
+	})
+	if err != nil {
+		t.Fatalf("NewRPCPool() error = %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	callCount := 0
+	err = pool.ExecuteWithFailover(ctx, 2, func(url string) error {
+		callCount++
+		cancel()
+		return ctx.Err()
+	})
+
+	if !errors.Is(err, context.Canceled) || strings.Contains(err.Error(), "retries exhausted") {
+		t.Fatalf("ExecuteWithFailover() error = %v, want context.Canceled", err)
+	}
+	if callCount != 1 {
+		t.Errorf("callCount = %d, want no failover after cancellation", callCount)
+	}
+	for _, ep := range pool.GetEndpoints() {
+		if !ep.Healthy {
+			t.Errorf("endpoint %s marked unhealthy by a canceled call", ep.URL)
+		}
+	}
+}
+
 func TestRPCPoolHealthCheck(t *testing.T) {
 	client := &http.Client{
 		Transport: roundTripperFunc(func(req *http.Request) (*http.Response, error) {
@@ -8,6 +8,7 @@ import (
 	"encoding/hex"
 	"encoding/json"
 	"fmt"
+	"log/slog"
 	"math/big"
 	"strconv"
 	"strings"
@@ -27,9 +28,14 @@ import (
 // TxBuilder builds and signs Neo N3 transactions.
 type TxBuilder struct {
 	client   *Client
+	txCtx    *TxContext // Shared nonce / chain height state
 	netMagic netmode.Magic
 	extraFee int64  // Additional network fee buffer (in GAS fractions)
 	blockBuf uint32 // ValidUntilBlock buffer (blocks ahead of current)
+	feeStrat FeeStrategy
+
+	maxResubmits int                                  // SubmitTx rebuilds after expiry rejections
+	onAttempt    func(context.Context, SubmitAttempt) // Optional SubmitTx attempt recorder
 }
 
 // NewTxBuilder creates a new transaction builder.
@@ -46,12 +52,33 @@ func NewTxBuilder(client *Client, networkID uint32) *TxBuilder {
 		magic = netmode.Magic(networkID)
 	}
 
+	var txCtx *TxContext
+	if client != nil {
+		txCtx = client.TxContext()
+	} else {
+		txCtx = NewTxContext(nil, DefaultValidUntilBlocks)
+	}
+
 	return &TxBuilder{
 		client:   client,
+		txCtx:    txCtx,
 		netMagic: magic,
-		extraFee: 100000, // 0.001 GAS extra buffer
-		blockBuf: 100,    // Valid for ~100 blocks (~25 minutes)
+		extraFee: defaultNetworkFeeBuffer,
+		blockBuf: DefaultValidUntilBlocks, // Valid for ~100 blocks (~25 minutes)
+		feeStrat: client.FeeStrategy(),
+
+		maxResubmits: client.MaxResubmits(),
+		onAttempt:    client.submitAttemptRecorder(),
+	}
+}
+
+// SetFeeStrategy overrides the fee strategy inherited from the client.
+func (b *TxBuilder) SetFeeStrategy(s FeeStrategy) error {
+	if err := s.Validate(); err != nil {
+		return err
 	}
+	b.feeStrat = s
+	return nil
 }
 
 // BuildAndSignTx builds a transaction from an invoke simulation and signs it.
@@ -82,21 +109,16 @@ func (b *TxBuilder) BuildAndSignTx(
 		return nil, fmt.Errorf("parse system fee: %w", err)
 	}
 
-	// 3. Get current block height for ValidUntilBlock
-	blockCount, err := b.client.GetBlockCount(ctx)
+	// 3. Resolve ValidUntilBlock from the (briefly cached) chain height
+	validUntilBlock, err := b.txCtx.ValidUntil(ctx, b.blockBuf)
 	if err != nil {
-		return nil, fmt.Errorf("get block count: %w", err)
-	}
-	maxValidUntilBlock := uint64(^uint32(0) - b.blockBuf)
-	if blockCount > maxValidUntilBlock {
-		return nil, fmt.Errorf("block height %d overflows uint32", blockCount)
+		return nil, err
 	}
-	validUntilBlock := uint32(blockCount) + b.blockBuf // #nosec G115 -- range checked above
 
 	// 4. Create transaction
 	tx := transaction.New(script, systemFee)
 	tx.ValidUntilBlock = validUntilBlock
-	nonce, err := randomNonce()
+	nonce, err := b.txCtx.NextNonce(account.ScriptHash())
 	if err != nil {
 		return nil, fmt.Errorf("generate nonce: %w", err)
 	}
@@ -117,9 +139,18 @@ func (b *TxBuilder) BuildAndSignTx(
 		},
 	}
 
-	// 7. Calculate network fee
-	networkFee := b.calculateNetworkFee(ctx, tx)
-	tx.NetworkFee = networkFee + b.extraFee
+	// 7. Calculate network fee and apply the fee strategy
+	requiredFee := b.calculateNetworkFee(ctx, tx)
+	networkFee, err := b.feeStrat.NetworkFee(requiredFee, b.extraFee)
+	if err != nil {
+		return nil, fmt.Errorf("network fee: %w", err)
+	}
+	tx.NetworkFee = networkFee
+	mode := b.feeStrat.Mode
+	if mode == "" {
+		mode = FeeModeNetwork
+	}
+	slog.Info("network fee selected", "mode", mode, "required", requiredFee, "network_fee", networkFee)
 
 	// 8. Sign transaction
 	if err := account.SignTx(b.netMagic, tx); err != nil {
@@ -0,0 +1,144 @@
+package chain
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/nspcc-dev/neo-go/pkg/util"
+)
+
+// =============================================================================
+// Transaction Context - Shared Nonce / ValidUntilBlock State
+// =============================================================================
+
+const (
+	// DefaultValidUntilBlocks is how many blocks ahead of the current height a
+	// transaction stays valid (~25 minutes at 15s blocks).
+	DefaultValidUntilBlocks uint32 = 100
+
+	// defaultHeightCacheTTL bounds how long a fetched block height is reused.
+	// Neo N3 produces a block roughly every 15s, so a few seconds of staleness
+	// only shortens the validity window marginally.
+	defaultHeightCacheTTL = 3 * time.Second
+)
+
+// TxContext centralizes transaction-building state that concurrent builders
+// would otherwise each manage: per-account nonces and the ValidUntilBlock
+// derived from the current chain height.
+//
+// Neo N3 nonces are not sequence numbers, but two transactions with the same
+// script, signer and nonce hash identically and the second is rejected as a
+// duplicate. NextNonce hands out distinct values per account so concurrent
+// builders never collide.
+type TxContext struct {
+	client         *Client
+	validityBlocks uint32
+	heightTTL      time.Duration
+
+	mu     sync.Mutex
+	nonces map[util.Uint160]uint32
+
+	heightMu  sync.Mutex
+	height    uint64
+	heightAt  time.Time
+	nowFn     func() time.Time
+	nonceSeed func() (uint32, error)
+}
+
+// NewTxContext creates a transaction context bound to client.
+// validityBlocks of 0 uses DefaultValidUntilBlocks.
+func NewTxContext(client *Client, validityBlocks uint32) *TxContext {
+	if validityBlocks == 0 {
+		validityBlocks = DefaultValidUntilBlocks
+	}
+	return &TxContext{
+		client:         client,
+		validityBlocks: validityBlocks,
+		heightTTL:      defaultHeightCacheTTL,
+		nonces:         make(map[util.Uint160]uint32),
+		nowFn:          time.Now,
+		nonceSeed:      randomNonce,
+	}
+}
+
+// NextNonce returns a nonce for account that is distinct from every nonce
+// previously handed out for the same account by this context. The sequence
+// starts at a random offset so restarts do not replay earlier values.
+func (c *TxContext) NextNonce(account util.Uint160) (uint32, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	last, ok := c.nonces[account]
+	if !ok {
+		seed, err := c.nonceSeed()
+		if err != nil {
+			return 0, fmt.Errorf("seed nonce: %w", err)
+		}
+		c.nonces[account] = seed
+		return seed, nil
+	}
+	next := last + 1 // wraps at 2^32, which is fine for uniqueness in practice
+	c.nonces[account] = next
+	return next, nil
+}
+
+// CurrentValidUntil returns the ValidUntilBlock for a transaction built now.
+// The chain height is cached briefly so bursts of builders share one RPC call.
+func (c *TxContext) CurrentValidUntil(ctx context.Context) (uint32, error) {
+	return c.ValidUntil(ctx, c.validityBlocks)
+}
+
+// ValidUntil is CurrentValidUntil with an explicit validity window in blocks.
+func (c *TxContext) ValidUntil(ctx context.Context, blocks uint32) (uint32, error) {
+	height, err := c.currentHeight(ctx)
+	if err != nil {
+		return 0, err
+	}
+	maxValidUntilBlock := uint64(^uint32(0) - blocks)
+	if height > maxValidUntilBlock {
+		return 0, fmt.Errorf("block height %d overflows uint32", height)
+	}
+	return uint32(height) + blocks, nil // #nosec G115 -- range checked above
+}
+
+// InvalidateHeight drops the cached height, forcing the next call to refetch.
+// Call it after a submission is rejected as expired.
+func (c *TxContext) InvalidateHeight() {
+	c.heightMu.Lock()
+	c.heightAt = time.Time{}
+	c.heightMu.Unlock()
+}
+
+func (c *TxContext) currentHeight(ctx context.Context) (uint64, error) {
+	c.heightMu.Lock()
+	defer c.heightMu.Unlock()
+
+	now := c.nowFn()
+	if !c.heightAt.IsZero() && now.Sub(c.heightAt) < c.heightTTL {
+		return c.height, nil
+	}
+	if c.client == nil {
+		return 0, fmt.Errorf("chain client is nil")
+	}
+
+	height, err := c.client.GetBlockCount(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("get block count: %w", err)
+	}
+	c.height = height
+	c.heightAt = now
+	return height, nil
+}
+
+// TxContext returns the client's shared transaction context, creating it on
+// first use. All TxBuilders created for this client share it.
+func (c *Client) TxContext() *TxContext {
+	c.txCtxMu.Lock()
+	defer c.txCtxMu.Unlock()
+	if c.txCtx == nil {
+		c.txCtx = NewTxContext(c, DefaultValidUntilBlocks)
+	}
+	return c.txCtx
+}
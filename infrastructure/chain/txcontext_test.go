@@ -0,0 +1,121 @@
+package chain
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/nspcc-dev/neo-go/pkg/util"
+)
+
+func TestTxContextNextNonce(t *testing.T) {
+	txCtx := NewTxContext(nil, 0)
+	txCtx.nonceSeed = func() (uint32, error) { return 41, nil }
+
+	alice := util.Uint160{1}
+	bob := util.Uint160{2}
+
+	if n, _ := txCtx.NextNonce(alice); n != 41 {
+		t.Fatalf("first nonce = %d, want 41", n)
+	}
+	if n, _ := txCtx.NextNonce(alice); n != 42 {
+		t.Fatalf("second nonce = %d, want 42", n)
+	}
+	if n, _ := txCtx.NextNonce(bob); n != 41 {
+		t.Fatalf("other account nonce = %d, want 41", n)
+	}
+}
+
+func TestTxContextNextNonceConcurrent(t *testing.T) {
+	txCtx := NewTxContext(nil, 0)
+	account := util.Uint160{7}
+
+	const n = 64
+	var (
+		wg   sync.WaitGroup
+		mu   sync.Mutex
+		seen = make(map[uint32]bool, n)
+	)
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			nonce, err := txCtx.NextNonce(account)
+			if err != nil {
+				t.Errorf("NextNonce() error = %v", err)
+				return
+			}
+			mu.Lock()
+			seen[nonce] = true
+			mu.Unlock()
+		}()
+	}
+	wg.Wait()
+
+	if len(seen) != n {
+		t.Fatalf("got %d distinct nonces, want %d", len(seen), n)
+	}
+}
+
+func TestTxContextCurrentValidUntilCachesHeight(t *testing.T) {
+	client, _ := NewClient(Config{RPCURL: "http://example"})
+	var calls int
+	height := 1000
+	client.httpClient.Transport = roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+		calls++
+		result, _ := json.Marshal(height)
+		payload, _ := json.Marshal(RPCResponse{JSONRPC: "2.0", ID: 1, Result: result})
+		return newResponse(payload), nil
+	})
+
+	txCtx := NewTxContext(client, 50)
+	now := time.Unix(1_700_000_000, 0)
+	txCtx.nowFn = func() time.Time { return now }
+	ctx := context.Background()
+
+	got, err := txCtx.CurrentValidUntil(ctx)
+	if err != nil {
+		t.Fatalf("CurrentValidUntil() error = %v", err)
+	}
+	if got != 1050 {
+		t.Fatalf("CurrentValidUntil() = %d, want 1050", got)
+	}
+
+	height = 1001
+	if got, _ = txCtx.CurrentValidUntil(ctx); got != 1050 || calls != 1 {
+		t.Fatalf("cached CurrentValidUntil() = %d after %d calls, want 1050 after 1", got, calls)
+	}
+	if got, _ = txCtx.ValidUntil(ctx, 10); got != 1010 {
+		t.Fatalf("ValidUntil(10) = %d, want 1010", got)
+	}
+
+	now = now.Add(defaultHeightCacheTTL)
+	if got, _ = txCtx.CurrentValidUntil(ctx); got != 1051 || calls != 2 {
+		t.Fatalf("expired CurrentValidUntil() = %d after %d calls, want 1051 after 2", got, calls)
+	}
+
+	height = 1002
+	txCtx.InvalidateHeight()
+	if got, _ = txCtx.CurrentValidUntil(ctx); got != 1052 || calls != 3 {
+		t.Fatalf("invalidated CurrentValidUntil() = %d after %d calls, want 1052 after 3", got, calls)
+	}
+}
+
+func TestTxContextNilClient(t *testing.T) {
+	if _, err := NewTxContext(nil, 0).CurrentValidUntil(context.Background()); err == nil {
+		t.Fatal("expected error without a client")
+	}
+}
+
+func TestClientTxContextShared(t *testing.T) {
+	client, _ := NewClient(Config{RPCURL: "http://example"})
+	if client.TxContext() != client.TxContext() {
+		t.Fatal("TxContext() should return the same instance")
+	}
+	if NewTxBuilder(client, 894710606).txCtx != client.TxContext() {
+		t.Fatal("TxBuilder should use the client's TxContext")
+	}
+}
@@ -33,19 +33,9 @@ import (
 //
 // This is the preferred way to load configuration values in Marble services.
 func EnvOrSecret(m *marble.Marble, envKey string, defaultValue string) string {
-	// Try Marble secret first (production/TEE mode)
-	if m != nil {
-		if secret, ok := m.Secret(envKey); ok && len(secret) > 0 {
-			return strings.TrimSpace(string(secret))
-		}
-	}
-
-	// Fallback to environment variable
-	value := strings.TrimSpace(os.Getenv(envKey))
-	if value != "" {
+	if value := m.SecretOrEnv(envKey, envKey); value != "" {
 		return value
 	}
-
 	return defaultValue
 }
 
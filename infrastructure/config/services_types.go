@@ -1,5 +1,7 @@
 package config
 
+import "strings"
+
 // ServiceSettings holds configuration for a single service from services.yaml.
 type ServiceSettings struct {
 	// Enabled determines if the service should run.
@@ -11,6 +13,11 @@ type ServiceSettings struct {
 	// Description is a human-readable description.
 	Description string `yaml:"description" json:"description"`
 
+	// NonCritical lists dependencies the service may start without. Only
+	// "database" is recognised: a failed hydrate then starts the service in
+	// degraded mode instead of failing.
+	NonCritical []string `yaml:"non_critical,omitempty" json:"non_critical,omitempty"`
+
 	// Extra holds any additional service-specific configuration.
 	Extra map[string]any `yaml:"extra,omitempty" json:"extra,omitempty"`
 }
@@ -33,6 +40,20 @@ func (c *ServicesConfig) IsEnabled(serviceID string) bool {
 	return settings.Enabled
 }
 
+// IsNonCritical reports whether a service marks dependency as non-critical.
+func (c *ServicesConfig) IsNonCritical(serviceID, dependency string) bool {
+	settings := c.GetSettings(serviceID)
+	if settings == nil {
+		return false
+	}
+	for _, dep := range settings.NonCritical {
+		if strings.EqualFold(strings.TrimSpace(dep), dependency) {
+			return true
+		}
+	}
+	return false
+}
+
 // GetSettings returns the settings for a service.
 // Returns nil if the service is not found.
 func (c *ServicesConfig) GetSettings(serviceID string) *ServiceSettings {
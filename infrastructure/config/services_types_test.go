@@ -46,6 +46,26 @@ func TestServicesConfigIsEnabled(t *testing.T) {
 	})
 }
 
+func TestServicesConfigIsNonCritical(t *testing.T) {
+	cfg := &ServicesConfig{
+		Services: map[string]*ServiceSettings{
+			"reader": {Enabled: true, NonCritical: []string{" Database "}},
+			"writer": {Enabled: true},
+		},
+	}
+
+	if !cfg.IsNonCritical("reader", "database") {
+		t.Error("IsNonCritical() should match case-insensitively")
+	}
+	if cfg.IsNonCritical("writer", "database") || cfg.IsNonCritical("missing", "database") {
+		t.Error("IsNonCritical() should be false when not listed")
+	}
+	var nilCfg *ServicesConfig
+	if nilCfg.IsNonCritical("reader", "database") {
+		t.Error("IsNonCritical() should return false for nil config")
+	}
+}
+
 func TestServicesConfigGetSettings(t *testing.T) {
 	cfg := &ServicesConfig{
 		Services: map[string]*ServiceSettings{
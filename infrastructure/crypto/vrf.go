@@ -0,0 +1,215 @@
+package crypto
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/hmac"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"math/big"
+)
+
+// =============================================================================
+// ECVRF (RFC 9381, ECVRF-P256-SHA256-TAI)
+// =============================================================================
+
+const (
+	vrfSuite    = 0x01
+	vrfPointLen = 33 // compressed P-256 point
+	vrfCLen     = 16 // challenge length
+	vrfQLen     = 32 // scalar length
+
+	// VRFProofSize is the length of an encoded proof: Gamma || c || s.
+	VRFProofSize = vrfPointLen + vrfCLen + vrfQLen
+)
+
+// ErrInvalidVRFProof is returned when a proof does not verify.
+var ErrInvalidVRFProof = errors.New("invalid VRF proof")
+
+// VRFProof is a decoded ECVRF proof.
+type VRFProof struct {
+	Gamma []byte // compressed point
+	C     []byte // 16-byte challenge
+	S     []byte // 32-byte response
+}
+
+// Bytes encodes the proof as Gamma || c || s.
+func (p *VRFProof) Bytes() []byte {
+	out := make([]byte, 0, VRFProofSize)
+	out = append(out, p.Gamma...)
+	out = append(out, p.C...)
+	return append(out, p.S...)
+}
+
+// DecodeVRFProof splits an encoded proof into its components.
+func DecodeVRFProof(proof []byte) (*VRFProof, error) {
+	if len(proof) != VRFProofSize {
+		return nil, fmt.Errorf("%w: length %d, want %d", ErrInvalidVRFProof, len(proof), VRFProofSize)
+	}
+	return &VRFProof{
+		Gamma: append([]byte{}, proof[:vrfPointLen]...),
+		C:     append([]byte{}, proof[vrfPointLen:vrfPointLen+vrfCLen]...),
+		S:     append([]byte{}, proof[vrfPointLen+vrfCLen:]...),
+	}, nil
+}
+
+// VRFProve computes the VRF proof and output (beta) for alpha.
+func VRFProve(priv *ecdsa.PrivateKey, alpha []byte) (*VRFProof, []byte, error) {
+	if priv == nil || priv.Curve != elliptic.P256() {
+		return nil, nil, fmt.Errorf("VRF requires a P-256 private key")
+	}
+	curve := elliptic.P256()
+	q := curve.Params().N
+	pk := PublicKeyToBytes(&priv.PublicKey)
+
+	hx, hy, err := vrfEncodeToCurve(pk, alpha)
+	if err != nil {
+		return nil, nil, err
+	}
+	hString := elliptic.MarshalCompressed(curve, hx, hy)
+
+	gx, gy := curve.ScalarMult(hx, hy, scalarBytes(priv.D))
+	k := vrfNonce(priv.D, hString)
+	ux, uy := curve.ScalarBaseMult(scalarBytes(k))
+	vx, vy := curve.ScalarMult(hx, hy, scalarBytes(k))
+
+	gamma := elliptic.MarshalCompressed(curve, gx, gy)
+	c := vrfChallenge(pk, hString, gamma,
+		elliptic.MarshalCompressed(curve, ux, uy),
+		elliptic.MarshalCompressed(curve, vx, vy))
+
+	s := new(big.Int).Mul(new(big.Int).SetBytes(c), priv.D)
+	s.Add(s, k)
+	s.Mod(s, q)
+
+	proof := &VRFProof{Gamma: gamma, C: c, S: scalarBytes(s)}
+	return proof, vrfProofToHash(gamma), nil
+}
+
+// VRFVerify checks proof against the public key and alpha and returns the
+// VRF output (beta) it commits to.
+func VRFVerify(pub *ecdsa.PublicKey, alpha, proof []byte) ([]byte, error) {
+	if pub == nil || pub.X == nil || pub.Y == nil {
+		return nil, fmt.Errorf("%w: missing public key", ErrInvalidVRFProof)
+	}
+	curve := elliptic.P256()
+	if !curve.IsOnCurve(pub.X, pub.Y) {
+		return nil, fmt.Errorf("%w: public key not on P-256", ErrInvalidVRFProof)
+	}
+	p, err := DecodeVRFProof(proof)
+	if err != nil {
+		return nil, err
+	}
+	gx, gy := elliptic.UnmarshalCompressed(curve, p.Gamma)
+	if gx == nil {
+		return nil, fmt.Errorf("%w: gamma is not a curve point", ErrInvalidVRFProof)
+	}
+	q := curve.Params().N
+	s := new(big.Int).SetBytes(p.S)
+	if s.Cmp(q) >= 0 {
+		return nil, fmt.Errorf("%w: s out of range", ErrInvalidVRFProof)
+	}
+	c := new(big.Int).SetBytes(p.C)
+	negC := scalarBytes(new(big.Int).Sub(q, c))
+
+	pk := PublicKeyToBytes(pub)
+	hx, hy, err := vrfEncodeToCurve(pk, alpha)
+	if err != nil {
+		return nil, err
+	}
+
+	// U = s*B - c*Y, V = s*H - c*Gamma
+	sbx, sby := curve.ScalarBaseMult(p.S)
+	cyx, cyy := curve.ScalarMult(pub.X, pub.Y, negC)
+	ux, uy := curve.Add(sbx, sby, cyx, cyy)
+	shx, shy := curve.ScalarMult(hx, hy, p.S)
+	cgx, cgy := curve.ScalarMult(gx, gy, negC)
+	vx, vy := curve.Add(shx, shy, cgx, cgy)
+
+	expected := vrfChallenge(pk, elliptic.MarshalCompressed(curve, hx, hy), p.Gamma,
+		elliptic.MarshalCompressed(curve, ux, uy),
+		elliptic.MarshalCompressed(curve, vx, vy))
+	if !hmac.Equal(expected, p.C) {
+		return nil, ErrInvalidVRFProof
+	}
+	return vrfProofToHash(p.Gamma), nil
+}
+
+// vrfEncodeToCurve hashes alpha to a curve point by try-and-increment.
+func vrfEncodeToCurve(pk, alpha []byte) (*big.Int, *big.Int, error) {
+	curve := elliptic.P256()
+	for ctr := 0; ctr < 256; ctr++ {
+		h := sha256.New()
+		h.Write([]byte{vrfSuite, 0x01})
+		h.Write(pk)
+		h.Write(alpha)
+		h.Write([]byte{byte(ctr), 0x00})
+		x, y := elliptic.UnmarshalCompressed(curve, append([]byte{0x02}, h.Sum(nil)...))
+		if x != nil {
+			return x, y, nil
+		}
+	}
+	return nil, nil, fmt.Errorf("VRF: no valid curve point for input")
+}
+
+// vrfChallenge hashes the proof points to the truncated challenge c.
+func vrfChallenge(points ...[]byte) []byte {
+	h := sha256.New()
+	h.Write([]byte{vrfSuite, 0x02})
+	for _, p := range points {
+		h.Write(p)
+	}
+	h.Write([]byte{0x00})
+	return h.Sum(nil)[:vrfCLen]
+}
+
+func vrfProofToHash(gamma []byte) []byte {
+	h := sha256.New()
+	h.Write([]byte{vrfSuite, 0x03})
+	h.Write(gamma) // cofactor is 1
+	h.Write([]byte{0x00})
+	return h.Sum(nil)
+}
+
+// vrfNonce derives the proof nonce deterministically per RFC 6979 with
+// SHA-256, using hString as the message.
+func vrfNonce(x *big.Int, hString []byte) *big.Int {
+	q := elliptic.P256().Params().N
+	h1 := sha256.Sum256(hString)
+	hInt := new(big.Int).SetBytes(h1[:])
+	hInt.Mod(hInt, q)
+
+	mac := func(key []byte, parts ...[]byte) []byte {
+		m := hmac.New(sha256.New, key)
+		for _, p := range parts {
+			m.Write(p)
+		}
+		return m.Sum(nil)
+	}
+
+	xb, hb := scalarBytes(x), scalarBytes(hInt)
+	v := bytes.Repeat([]byte{0x01}, sha256.Size)
+	key := make([]byte, sha256.Size)
+	key = mac(key, v, []byte{0x00}, xb, hb)
+	v = mac(key, v)
+	key = mac(key, v, []byte{0x01}, xb, hb)
+	v = mac(key, v)
+
+	for {
+		v = mac(key, v)
+		k := new(big.Int).SetBytes(v)
+		if k.Sign() > 0 && k.Cmp(q) < 0 {
+			return k
+		}
+		key = mac(key, v, []byte{0x00})
+		v = mac(key, v)
+	}
+}
+
+// scalarBytes encodes a scalar as 32 big-endian bytes.
+func scalarBytes(n *big.Int) []byte {
+	out := make([]byte, vrfQLen)
+	return n.FillBytes(out)
+}
@@ -0,0 +1,77 @@
+package crypto
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"encoding/hex"
+	"errors"
+	"math/big"
+	"testing"
+)
+
+func vrfTestKey(t *testing.T, skHex string) *ecdsa.PrivateKey {
+	t.Helper()
+	d, ok := new(big.Int).SetString(skHex, 16)
+	if !ok {
+		t.Fatalf("bad key %q", skHex)
+	}
+	curve := elliptic.P256()
+	priv := &ecdsa.PrivateKey{PublicKey: ecdsa.PublicKey{Curve: curve}, D: d}
+	priv.PublicKey.X, priv.PublicKey.Y = curve.ScalarBaseMult(d.Bytes())
+	return priv
+}
+
+// RFC 9381 Appendix B.1, ECVRF-P256-SHA256-TAI example 10.
+func TestVRFProveRFC9381Vector(t *testing.T) {
+	priv := vrfTestKey(t, "c9afa9d845ba75166b5c215767b1d6934e50c3db36e89b127b8a622b120f6721")
+	if got := hex.EncodeToString(PublicKeyToBytes(&priv.PublicKey)); got != "0360fed4ba255a9d31c961eb74c6356d68c049b8923b61fa6ce669622e60f29fb6" {
+		t.Fatalf("public key = %s", got)
+	}
+
+	proof, beta, err := VRFProve(priv, []byte("sample"))
+	if err != nil {
+		t.Fatalf("VRFProve() error = %v", err)
+	}
+	wantPi := "035b5c726e8c0e2c488a107c600578ee75cb702343c153cb1eb8dec77f4b5071b4" +
+		"a53f0a46f018bc2c56e58d383f2305e0975972c26feea0eb122fe7893c15af376b33edf7de17c6ea056d4d82de6bc02f"
+	if got := hex.EncodeToString(proof.Bytes()); got != wantPi {
+		t.Fatalf("pi = %s, want %s", got, wantPi)
+	}
+	if got := hex.EncodeToString(beta); got != "a3ad7b0ef73d8fc6655053ea22f9bede8c743f08bbed3d38821f0e16474b505e" {
+		t.Fatalf("beta = %s", got)
+	}
+}
+
+func TestVRFVerify(t *testing.T) {
+	keyPair, err := GenerateKeyPair()
+	if err != nil {
+		t.Fatalf("GenerateKeyPair() error = %v", err)
+	}
+	proof, beta, err := VRFProve(keyPair.PrivateKey, []byte("seed"))
+	if err != nil {
+		t.Fatalf("VRFProve() error = %v", err)
+	}
+
+	got, err := VRFVerify(keyPair.PublicKey, []byte("seed"), proof.Bytes())
+	if err != nil {
+		t.Fatalf("VRFVerify() error = %v", err)
+	}
+	if hex.EncodeToString(got) != hex.EncodeToString(beta) {
+		t.Fatalf("beta = %x, want %x", got, beta)
+	}
+
+	if _, err := VRFVerify(keyPair.PublicKey, []byte("other seed"), proof.Bytes()); !errors.Is(err, ErrInvalidVRFProof) {
+		t.Fatalf("wrong seed err = %v, want ErrInvalidVRFProof", err)
+	}
+
+	tampered := proof.Bytes()
+	tampered[len(tampered)-1] ^= 0x01
+	if _, err := VRFVerify(keyPair.PublicKey, []byte("seed"), tampered); !errors.Is(err, ErrInvalidVRFProof) {
+		t.Fatalf("tampered proof err = %v, want ErrInvalidVRFProof", err)
+	}
+
+	other, _ := GenerateKeyPair()
+	if _, err := VRFVerify(other.PublicKey, []byte("seed"), proof.Bytes()); !errors.Is(err, ErrInvalidVRFProof) {
+		t.Fatalf("wrong key err = %v, want ErrInvalidVRFProof", err)
+	}
+}
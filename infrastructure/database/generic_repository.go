@@ -186,9 +186,11 @@ func GenericDeleteWithQuery(base *Repository, ctx context.Context, table, query
 
 // QueryBuilder helps construct Supabase REST queries.
 type QueryBuilder struct {
-	filters []string
-	order   string
-	limit   int
+	selectCols string
+	filters    []string
+	order      string
+	limit      int
+	offset     int
 }
 
 // NewQuery creates a new query builder.
@@ -232,6 +234,30 @@ func (q *QueryBuilder) Gte(field, value string) *QueryBuilder {
 	return q
 }
 
+// Neq adds a not-equal filter: field=neq.value
+func (q *QueryBuilder) Neq(field, value string) *QueryBuilder {
+	q.filters = append(q.filters, fmt.Sprintf("%s=neq.%s", field, url.QueryEscape(value)))
+	return q
+}
+
+// Lt adds a less than filter: field=lt.value
+func (q *QueryBuilder) Lt(field, value string) *QueryBuilder {
+	q.filters = append(q.filters, fmt.Sprintf("%s=lt.%s", field, url.QueryEscape(value)))
+	return q
+}
+
+// Gt adds a greater than filter: field=gt.value
+func (q *QueryBuilder) Gt(field, value string) *QueryBuilder {
+	q.filters = append(q.filters, fmt.Sprintf("%s=gt.%s", field, url.QueryEscape(value)))
+	return q
+}
+
+// EqInt adds an integer equality filter: field=eq.value
+func (q *QueryBuilder) EqInt(field string, value int64) *QueryBuilder {
+	q.filters = append(q.filters, fmt.Sprintf("%s=eq.%d", field, value))
+	return q
+}
+
 // In adds an IN filter: field=in.(value1,value2,...)
 // This is useful for batch queries to avoid N+1 problems.
 func (q *QueryBuilder) In(field string, values []string) *QueryBuilder {
@@ -246,6 +272,17 @@ func (q *QueryBuilder) In(field string, values []string) *QueryBuilder {
 	return q
 }
 
+// Or adds a disjunction of PostgREST conditions: or=(cond1,cond2,...)
+// Conditions use PostgREST's "field.op.value" form, e.g. "id.lt.abc" or
+// "and(a.eq.1,b.lt.2)"; values must already be escaped.
+func (q *QueryBuilder) Or(conditions ...string) *QueryBuilder {
+	if len(conditions) == 0 {
+		return q
+	}
+	q.filters = append(q.filters, fmt.Sprintf("or=(%s)", joinStrings(conditions, ",")))
+	return q
+}
+
 // joinStrings joins strings with a separator (avoiding strings package import).
 func joinStrings(strs []string, sep string) string {
 	if len(strs) == 0 {
@@ -270,17 +307,59 @@ func (q *QueryBuilder) OrderDesc(field string) *QueryBuilder {
 	return q
 }
 
+// ThenAsc appends a secondary ascending order: order=prev,field.asc
+// Without a preceding OrderAsc/OrderDesc it behaves like OrderAsc.
+func (q *QueryBuilder) ThenAsc(field string) *QueryBuilder {
+	if q.order == "" {
+		return q.OrderAsc(field)
+	}
+	q.order += fmt.Sprintf(",%s.asc", field)
+	return q
+}
+
+// ThenDesc appends a secondary descending order: order=prev,field.desc
+// Without a preceding OrderAsc/OrderDesc it behaves like OrderDesc.
+func (q *QueryBuilder) ThenDesc(field string) *QueryBuilder {
+	if q.order == "" {
+		return q.OrderDesc(field)
+	}
+	q.order += fmt.Sprintf(",%s.desc", field)
+	return q
+}
+
+// Select restricts the returned columns: select=col1,col2
+func (q *QueryBuilder) Select(columns ...string) *QueryBuilder {
+	q.selectCols = joinStrings(columns, ",")
+	return q
+}
+
 // Limit sets the result limit.
 func (q *QueryBuilder) Limit(n int) *QueryBuilder {
 	q.limit = n
 	return q
 }
 
+// Offset sets the number of rows to skip.
+func (q *QueryBuilder) Offset(n int) *QueryBuilder {
+	q.offset = n
+	return q
+}
+
+// Page applies validated pagination parameters (limit and offset).
+func (q *QueryBuilder) Page(p PaginationParams) *QueryBuilder {
+	q.limit = p.Limit
+	q.offset = p.Offset
+	return q
+}
+
 // Build constructs the final query string.
 func (q *QueryBuilder) Build() string {
 	result := ""
-	for i, f := range q.filters {
-		if i > 0 {
+	if q.selectCols != "" {
+		result += "select=" + q.selectCols
+	}
+	for _, f := range q.filters {
+		if result != "" {
 			result += "&"
 		}
 		result += f
@@ -297,5 +376,11 @@ func (q *QueryBuilder) Build() string {
 		}
 		result += fmt.Sprintf("limit=%d", q.limit)
 	}
+	if q.offset > 0 {
+		if result != "" {
+			result += "&"
+		}
+		result += fmt.Sprintf("offset=%d", q.offset)
+	}
 	return result
 }
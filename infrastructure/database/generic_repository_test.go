@@ -112,6 +112,17 @@ func TestQueryBuilderLimit(t *testing.T) {
 	}
 }
 
+func TestQueryBuilderOr(t *testing.T) {
+	q := NewQuery().Or("executed_at.lt.t1", "and(executed_at.eq.t1,id.lt.e1)")
+	want := "or=(executed_at.lt.t1,and(executed_at.eq.t1,id.lt.e1))"
+	if result := q.Build(); result != want {
+		t.Errorf("Build() = %q, want %q", result, want)
+	}
+	if result := NewQuery().Or().Build(); result != "" {
+		t.Errorf("empty Or() Build() = %q, want empty", result)
+	}
+}
+
 func TestQueryBuilderChaining(t *testing.T) {
 	q := NewQuery().
 		Eq("user_id", "123").
@@ -175,6 +186,58 @@ func TestQueryBuilderMultipleFilters(t *testing.T) {
 	}
 }
 
+func TestQueryBuilderComparisonFilters(t *testing.T) {
+	tests := []struct {
+		name string
+		q    *QueryBuilder
+		want string
+	}{
+		{"neq", NewQuery().Neq("status", "failed"), "status=neq.failed"},
+		{"lt", NewQuery().Lt("amount", "10"), "amount=lt.10"},
+		{"gt", NewQuery().Gt("amount", "10"), "amount=gt.10"},
+		{"eq int", NewQuery().EqInt("balance", -5), "balance=eq.-5"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.q.Build(); got != tt.want {
+				t.Errorf("Build() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestQueryBuilderSecondaryOrder(t *testing.T) {
+	q := NewQuery().OrderDesc("is_primary").ThenAsc("created_at")
+	if got, want := q.Build(), "order=is_primary.desc,created_at.asc"; got != want {
+		t.Errorf("Build() = %q, want %q", got, want)
+	}
+
+	q = NewQuery().ThenDesc("created_at")
+	if got, want := q.Build(), "order=created_at.desc"; got != want {
+		t.Errorf("Build() = %q, want %q", got, want)
+	}
+}
+
+func TestQueryBuilderSelectAndPagination(t *testing.T) {
+	q := NewQuery().
+		Select("id", "name").
+		Eq("user_id", "u1").
+		OrderDesc("created_at").
+		Page(NewPagination(20, 40))
+
+	want := "select=id,name&user_id=eq.u1&order=created_at.desc&limit=20&offset=40"
+	if got := q.Build(); got != want {
+		t.Errorf("Build() = %q, want %q", got, want)
+	}
+}
+
+func TestQueryBuilderZeroOffsetOmitted(t *testing.T) {
+	q := NewQuery().Limit(10).Offset(0)
+	if got := q.Build(); got != "limit=10" {
+		t.Errorf("Build() = %q, want %q", got, "limit=10")
+	}
+}
+
 // =============================================================================
 // Generic Repository Tests with Mock Server
 // =============================================================================
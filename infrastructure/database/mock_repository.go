@@ -4,6 +4,9 @@ package database
 import (
 	"context"
 	"sync"
+	"time"
+
+	"github.com/google/uuid"
 )
 
 // MockRepository is an in-memory implementation of RepositoryInterface for testing.
@@ -17,6 +20,7 @@ type MockRepository struct {
 	gasBankAccounts     map[string]*GasBankAccount
 	gasBankTransactions map[string]*GasBankTransaction
 	depositRequests     map[string]*DepositRequest
+	txAttempts          []TxAttempt
 
 	// Error injection for testing error paths
 	ErrorOnNextCall error
@@ -54,6 +58,7 @@ func (m *MockRepository) Reset() {
 	m.gasBankAccounts = make(map[string]*GasBankAccount)
 	m.gasBankTransactions = make(map[string]*GasBankTransaction)
 	m.depositRequests = make(map[string]*DepositRequest)
+	m.txAttempts = nil
 	m.ErrorOnNextCall = nil
 }
 
@@ -65,5 +70,29 @@ func (m *MockRepository) HealthCheck(ctx context.Context) error {
 	return nil
 }
 
+// CreateTxAttempt records a transaction submission attempt in memory.
+func (m *MockRepository) CreateTxAttempt(ctx context.Context, attempt *TxAttempt) error {
+	if err := m.checkError(); err != nil {
+		return err
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if attempt.ID == "" {
+		attempt.ID = uuid.New().String()
+	}
+	if attempt.CreatedAt.IsZero() {
+		attempt.CreatedAt = time.Now()
+	}
+	m.txAttempts = append(m.txAttempts, *attempt)
+	return nil
+}
+
+// TxAttempts returns a copy of the recorded transaction attempts.
+func (m *MockRepository) TxAttempts() []TxAttempt {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return append([]TxAttempt(nil), m.txAttempts...)
+}
+
 // Ensure MockRepository implements RepositoryInterface
 var _ RepositoryInterface = (*MockRepository)(nil)
@@ -55,6 +55,12 @@ type GasBankRepository interface {
 	GetPendingDeposits(ctx context.Context, limit int) ([]DepositRequest, error)
 }
 
+// TxAttemptRepository records transaction submission attempts, including
+// permanently rejected (dead-lettered) ones.
+type TxAttemptRepository interface {
+	CreateTxAttempt(ctx context.Context, attempt *TxAttempt) error
+}
+
 // =============================================================================
 // Base Repository Interface (For marble.Service framework)
 // =============================================================================
@@ -78,6 +84,7 @@ type BaseRepository interface {
 type RepositoryInterface interface {
 	BaseRepository
 	PriceFeedRepository
+	TxAttemptRepository
 	// HealthCheck verifies connectivity with the underlying database.
 	HealthCheck(ctx context.Context) error
 }
@@ -42,7 +42,7 @@ func (r *Repository) GetAPIKeys(ctx context.Context, userID string) ([]APIKey, e
 		return nil, err
 	}
 
-	query := fmt.Sprintf("user_id=eq.%s&revoked=eq.false&order=created_at.desc", url.QueryEscape(userID))
+	query := NewQuery().Eq("user_id", userID).IsFalse("revoked").OrderDesc("created_at").Build()
 	data, err := r.client.request(ctx, "GET", "api_keys", nil, query)
 	if err != nil {
 		return nil, fmt.Errorf("%w: get api keys: %v", ErrDatabaseError, err)
@@ -62,7 +62,7 @@ func (r *Repository) GetAPIKeyByHash(ctx context.Context, keyHash string) (*APIK
 	}
 	keyHash = SanitizeString(keyHash)
 
-	query := fmt.Sprintf("key_hash=eq.%s&revoked=eq.false&limit=1", url.QueryEscape(keyHash))
+	query := NewQuery().Eq("key_hash", keyHash).IsFalse("revoked").Limit(1).Build()
 	data, err := r.client.request(ctx, "GET", "api_keys", nil, query)
 	if err != nil {
 		return nil, fmt.Errorf("%w: get api key by hash: %v", ErrDatabaseError, err)
@@ -90,7 +90,7 @@ func (r *Repository) RevokeAPIKey(ctx context.Context, keyID, userID string) err
 	update := map[string]interface{}{
 		"revoked": true,
 	}
-	query := fmt.Sprintf("id=eq.%s&user_id=eq.%s", url.QueryEscape(keyID), url.QueryEscape(userID))
+	query := NewQuery().Eq("id", keyID).Eq("user_id", userID).Build()
 	_, err := r.client.request(ctx, "PATCH", "api_keys", update, query)
 	if err != nil {
 		return fmt.Errorf("%w: revoke api key: %v", ErrDatabaseError, err)
@@ -190,7 +190,7 @@ func (r *Repository) DeductFeeAtomic(ctx context.Context, userID string, amount
 		"updated_at": time.Now(),
 	}
 	// Use conditional update: only update if balance still matches expected value
-	query := fmt.Sprintf("user_id=eq.%s&balance=eq.%d", userID, account.Balance)
+	query := NewQuery().Eq("user_id", userID).EqInt("balance", account.Balance).Build()
 	_, err = r.client.request(ctx, "PATCH", "gasbank_accounts", update, query)
 	if err != nil {
 		// Balance update failed - transaction record exists but balance unchanged
@@ -234,7 +234,7 @@ func (r *Repository) ConfirmDepositAtomic(ctx context.Context, userID string, de
 		"updated_at": time.Now(),
 	}
 	// Use conditional update: only update if balance still matches expected value
-	query := fmt.Sprintf("user_id=eq.%s&balance=eq.%d", userID, account.Balance)
+	query := NewQuery().Eq("user_id", userID).EqInt("balance", account.Balance).Build()
 	_, err = r.client.request(ctx, "PATCH", "gasbank_accounts", update, query)
 	if err != nil {
 		// Balance update failed - transaction record exists but balance unchanged
@@ -252,7 +252,7 @@ func (r *Repository) GetGasBankTransactions(ctx context.Context, accountID strin
 	}
 	limit = ValidateLimit(limit, 50, 1000)
 
-	query := fmt.Sprintf("account_id=eq.%s&order=created_at.desc&limit=%d", accountID, limit)
+	query := NewQuery().Eq("account_id", accountID).OrderDesc("created_at").Limit(limit).Build()
 	data, err := r.client.request(ctx, "GET", "gasbank_transactions", nil, query)
 	if err != nil {
 		return nil, fmt.Errorf("%w: get gasbank transactions: %v", ErrDatabaseError, err)
@@ -275,7 +275,12 @@ func (r *Repository) ExistsTransactionByReference(ctx context.Context, accountID
 		return false, fmt.Errorf("%w: referenceID and txType are required", ErrInvalidInput)
 	}
 
-	query := fmt.Sprintf("account_id=eq.%s&reference_id=eq.%s&tx_type=eq.%s&limit=1", accountID, referenceID, txType)
+	query := NewQuery().
+		Eq("account_id", accountID).
+		Eq("reference_id", referenceID).
+		Eq("tx_type", txType).
+		Limit(1).
+		Build()
 	data, err := r.client.request(ctx, "GET", "gasbank_transactions", nil, query)
 	if err != nil {
 		return false, fmt.Errorf("%w: check transaction existence: %v", ErrDatabaseError, err)
@@ -322,7 +327,7 @@ func (r *Repository) GetDepositRequests(ctx context.Context, userID string, limi
 	}
 	limit = ValidateLimit(limit, 50, 1000)
 
-	query := fmt.Sprintf("user_id=eq.%s&order=created_at.desc&limit=%d", userID, limit)
+	query := NewQuery().Eq("user_id", userID).OrderDesc("created_at").Limit(limit).Build()
 	data, err := r.client.request(ctx, "GET", "deposit_requests", nil, query)
 	if err != nil {
 		return nil, fmt.Errorf("%w: get deposit requests: %v", ErrDatabaseError, err)
@@ -341,7 +346,7 @@ func (r *Repository) GetDepositByTxHash(ctx context.Context, txHash string) (*De
 		return nil, err
 	}
 
-	query := fmt.Sprintf("tx_hash=eq.%s&limit=1", txHash)
+	query := NewQuery().Eq("tx_hash", txHash).Limit(1).Build()
 	data, err := r.client.request(ctx, "GET", "deposit_requests", nil, query)
 	if err != nil {
 		return nil, fmt.Errorf("%w: get deposit by tx_hash: %v", ErrDatabaseError, err)
@@ -386,7 +391,11 @@ func (r *Repository) GetPendingDeposits(ctx context.Context, limit int) ([]Depos
 	limit = ValidateLimit(limit, 100, 1000)
 
 	// Query for pending and confirming deposits, ordered by creation time.
-	query := fmt.Sprintf("status=in.(pending,confirming)&order=created_at.asc&limit=%d", limit)
+	query := NewQuery().
+		In("status", []string{"pending", "confirming"}).
+		OrderAsc("created_at").
+		Limit(limit).
+		Build()
 	data, err := r.client.request(ctx, "GET", "deposit_requests", nil, query)
 	if err != nil {
 		return nil, fmt.Errorf("%w: get pending deposits: %v", ErrDatabaseError, err)
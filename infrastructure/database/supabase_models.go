@@ -57,6 +57,8 @@ type PriceFeed struct {
 	Timestamp time.Time `json:"timestamp"`
 	Sources   []string  `json:"sources"`
 	Signature []byte    `json:"signature"`
+	// SourceValues maps each contributing source to the price it reported.
+	SourceValues map[string]float64 `json:"source_values,omitempty"`
 }
 
 // GasBankAccount represents a gas bank account.
@@ -113,3 +115,28 @@ type GasBankTransaction struct {
 	Status       string    `json:"status,omitempty"`
 	CreatedAt    time.Time `json:"created_at"`
 }
+
+// Transaction attempt statuses.
+const (
+	TxAttemptAccepted   = "accepted"
+	TxAttemptRejected   = "rejected"
+	TxAttemptDeadLetter = "dead_letter"
+)
+
+// TxAttempt records one transaction submission attempt. Attempts with status
+// TxAttemptDeadLetter were rejected permanently and are parked for review
+// rather than retried.
+type TxAttempt struct {
+	ID              string    `json:"id,omitempty"`
+	ServiceID       string    `json:"service_id"`
+	AccountID       string    `json:"account_id,omitempty"`
+	ContractAddress string    `json:"contract_address,omitempty"`
+	Method          string    `json:"method,omitempty"`
+	TxHash          string    `json:"tx_hash"`
+	Attempt         int       `json:"attempt"`
+	ValidUntilBlock uint32    `json:"valid_until_block"`
+	Nonce           uint32    `json:"nonce"`
+	Status          string    `json:"status"`
+	Error           string    `json:"error,omitempty"`
+	CreatedAt       time.Time `json:"created_at"`
+}
@@ -221,7 +221,7 @@ func (r *Repository) GetServiceRequests(ctx context.Context, userID string, limi
 	}
 	limit = ValidateLimit(limit, 50, 1000)
 
-	query := fmt.Sprintf("user_id=eq.%s&order=created_at.desc&limit=%d", url.QueryEscape(userID), limit)
+	query := NewQuery().Eq("user_id", userID).OrderDesc("created_at").Limit(limit).Build()
 	data, err := r.client.request(ctx, "GET", "service_requests", nil, query)
 	if err != nil {
 		return nil, fmt.Errorf("%w: get service requests: %v", ErrDatabaseError, err)
@@ -277,7 +277,7 @@ func (r *Repository) GetLatestPrice(ctx context.Context, feedID string) (*PriceF
 	}
 	feedID = SanitizeString(feedID)
 
-	query := fmt.Sprintf("feed_id=eq.%s&order=timestamp.desc&limit=1", url.QueryEscape(feedID))
+	query := NewQuery().Eq("feed_id", feedID).OrderDesc("timestamp").Limit(1).Build()
 	data, err := r.client.request(ctx, "GET", "price_feeds", nil, query)
 	if err != nil {
 		return nil, fmt.Errorf("%w: get latest price: %v", ErrDatabaseError, err)
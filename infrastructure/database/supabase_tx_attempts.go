@@ -0,0 +1,35 @@
+package database
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// =============================================================================
+// Transaction Attempt Operations
+// =============================================================================
+
+// CreateTxAttempt records a transaction submission attempt.
+func (r *Repository) CreateTxAttempt(ctx context.Context, attempt *TxAttempt) error {
+	if attempt == nil {
+		return fmt.Errorf("%w: tx attempt cannot be nil", ErrInvalidInput)
+	}
+	if strings.TrimSpace(attempt.ServiceID) == "" {
+		return fmt.Errorf("%w: service_id cannot be empty", ErrInvalidInput)
+	}
+
+	data, err := r.client.request(ctx, "POST", "chain_tx_attempts", attempt, "")
+	if err != nil {
+		return fmt.Errorf("%w: create tx attempt: %v", ErrDatabaseError, err)
+	}
+	var attempts []TxAttempt
+	if err := json.Unmarshal(data, &attempts); err != nil {
+		return fmt.Errorf("%w: unmarshal tx attempts: %v", ErrDatabaseError, err)
+	}
+	if len(attempts) > 0 {
+		attempt.ID = attempts[0].ID
+	}
+	return nil
+}
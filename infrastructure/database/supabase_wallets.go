@@ -39,7 +39,7 @@ func (r *Repository) GetUserWallets(ctx context.Context, userID string) ([]UserW
 		return nil, err
 	}
 
-	query := fmt.Sprintf("user_id=eq.%s&order=is_primary.desc,created_at.asc", userID)
+	query := NewQuery().Eq("user_id", userID).OrderDesc("is_primary").ThenAsc("created_at").Build()
 	data, err := r.client.request(ctx, "GET", "user_wallets", nil, query)
 	if err != nil {
 		return nil, fmt.Errorf("%w: get user wallets: %v", ErrDatabaseError, err)
@@ -58,7 +58,7 @@ func (r *Repository) GetWalletByAddress(ctx context.Context, address string) (*U
 		return nil, err
 	}
 
-	query := fmt.Sprintf("address=eq.%s&limit=1", address)
+	query := NewQuery().Eq("address", address).Limit(1).Build()
 	data, err := r.client.request(ctx, "GET", "user_wallets", nil, query)
 	if err != nil {
 		return nil, fmt.Errorf("%w: get wallet by address: %v", ErrDatabaseError, err)
@@ -83,7 +83,7 @@ func (r *Repository) GetWallet(ctx context.Context, walletID, userID string) (*U
 		return nil, err
 	}
 
-	query := fmt.Sprintf("id=eq.%s&user_id=eq.%s", walletID, userID)
+	query := NewQuery().Eq("id", walletID).Eq("user_id", userID).Build()
 	resp, err := r.client.request(ctx, "GET", "user_wallets", nil, query)
 	if err != nil {
 		return nil, fmt.Errorf("%w: get wallet: %v", ErrDatabaseError, err)
@@ -109,7 +109,7 @@ func (r *Repository) SetPrimaryWallet(ctx context.Context, userID, walletID stri
 
 	// Then set the specified wallet as primary
 	update = map[string]interface{}{"is_primary": true}
-	query := fmt.Sprintf("id=eq.%s&user_id=eq.%s", walletID, userID)
+	query := NewQuery().Eq("id", walletID).Eq("user_id", userID).Build()
 	_, err = r.client.request(ctx, "PATCH", "user_wallets", update, query)
 	return err
 }
@@ -126,7 +126,7 @@ func (r *Repository) VerifyWallet(ctx context.Context, walletID, signature strin
 
 // DeleteWallet deletes a wallet binding.
 func (r *Repository) DeleteWallet(ctx context.Context, walletID, userID string) error {
-	query := fmt.Sprintf("id=eq.%s&user_id=eq.%s&is_primary=eq.false", walletID, userID)
+	query := NewQuery().Eq("id", walletID).Eq("user_id", userID).IsFalse("is_primary").Build()
 	_, err := r.client.request(ctx, "DELETE", "user_wallets", nil, query)
 	return err
 }
@@ -0,0 +1,84 @@
+package httputil
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// PageParams holds validated list pagination parameters.
+type PageParams struct {
+	Limit  int
+	Offset int
+	// Cursor is the decoded keyset cursor, or nil when the request has none.
+	Cursor *PageCursor
+}
+
+// PageCursor is the position after the last row of a page in a keyset
+// (sort value, id) ordering. Clients treat the encoded form as opaque.
+type PageCursor struct {
+	SortValue string `json:"s"`
+	ID        string `json:"id"`
+}
+
+// NextCursorHeader carries the cursor for the next page of a list response.
+const NextCursorHeader = "X-Next-Cursor"
+
+// ParsePageParams parses limit, offset and cursor query parameters.
+// A missing, malformed or non-positive limit uses defaultLimit; larger values
+// are capped at maxLimit. A malformed offset or cursor is reported as an error
+// so the handler can return 400 instead of serving the wrong page.
+func ParsePageParams(r *http.Request, defaultLimit, maxLimit int) (PageParams, error) {
+	query := r.URL.Query()
+	params := PageParams{Limit: defaultLimit}
+
+	if raw := strings.TrimSpace(query.Get("limit")); raw != "" {
+		if limit, err := strconv.Atoi(raw); err == nil && limit > 0 {
+			params.Limit = limit
+		}
+	}
+	if maxLimit > 0 && params.Limit > maxLimit {
+		params.Limit = maxLimit
+	}
+
+	if raw := strings.TrimSpace(query.Get("offset")); raw != "" {
+		offset, err := strconv.Atoi(raw)
+		if err != nil || offset < 0 {
+			return PageParams{}, fmt.Errorf("offset must be a non-negative integer")
+		}
+		params.Offset = offset
+	}
+
+	if raw := strings.TrimSpace(query.Get("cursor")); raw != "" {
+		cursor, err := DecodeCursor(raw)
+		if err != nil {
+			return PageParams{}, err
+		}
+		params.Cursor = &cursor
+	}
+
+	return params, nil
+}
+
+// EncodeNextCursor returns an opaque cursor pointing after the row with the
+// given sort value and id.
+func EncodeNextCursor(sortValue, id string) string {
+	raw, _ := json.Marshal(PageCursor{SortValue: sortValue, ID: id}) // two string fields cannot fail
+	return base64.RawURLEncoding.EncodeToString(raw)
+}
+
+// DecodeCursor parses a cursor produced by EncodeNextCursor.
+func DecodeCursor(raw string) (PageCursor, error) {
+	decoded, err := base64.RawURLEncoding.DecodeString(raw)
+	if err != nil {
+		return PageCursor{}, fmt.Errorf("invalid cursor")
+	}
+	var cursor PageCursor
+	if err := json.Unmarshal(decoded, &cursor); err != nil || cursor.ID == "" {
+		return PageCursor{}, fmt.Errorf("invalid cursor")
+	}
+	return cursor, nil
+}
@@ -0,0 +1,63 @@
+package httputil
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestParsePageParams(t *testing.T) {
+	cursor := EncodeNextCursor("2025-01-02T03:04:05Z", "exec-9")
+
+	tests := []struct {
+		name       string
+		query      string
+		wantLimit  int
+		wantOffset int
+		wantCursor bool
+		wantErr    bool
+	}{
+		{name: "defaults", query: "", wantLimit: 50},
+		{name: "explicit", query: "?limit=20&offset=40", wantLimit: 20, wantOffset: 40},
+		{name: "capped", query: "?limit=5000", wantLimit: 500},
+		{name: "cursor", query: "?cursor=" + cursor, wantLimit: 50, wantCursor: true},
+		{name: "non-numeric limit", query: "?limit=abc", wantLimit: 50},
+		{name: "zero limit", query: "?limit=0", wantLimit: 50},
+		{name: "negative offset", query: "?offset=-1", wantErr: true},
+		{name: "bad cursor", query: "?cursor=not*base64", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/items"+tt.query, nil)
+			page, err := ParsePageParams(req, 50, 500)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParsePageParams() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if page.Limit != tt.wantLimit || page.Offset != tt.wantOffset {
+				t.Fatalf("page = %+v, want limit %d offset %d", page, tt.wantLimit, tt.wantOffset)
+			}
+			if (page.Cursor != nil) != tt.wantCursor {
+				t.Fatalf("cursor = %+v, want present=%v", page.Cursor, tt.wantCursor)
+			}
+		})
+	}
+}
+
+func TestCursorRoundTrip(t *testing.T) {
+	encoded := EncodeNextCursor("2025-01-02T03:04:05Z", "exec-9")
+	cursor, err := DecodeCursor(encoded)
+	if err != nil {
+		t.Fatalf("DecodeCursor() error = %v", err)
+	}
+	if cursor.SortValue != "2025-01-02T03:04:05Z" || cursor.ID != "exec-9" {
+		t.Fatalf("cursor = %+v", cursor)
+	}
+
+	if _, err := DecodeCursor(EncodeNextCursor("x", "")); err == nil {
+		t.Fatal("expected error for cursor without id")
+	}
+}
@@ -0,0 +1,89 @@
+package logging
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+)
+
+// W3C Trace Context (https://www.w3.org/TR/trace-context/) propagation.
+//
+// Services identify requests by the X-Trace-ID header. traceparent carries the
+// same trace across hops that only understand W3C context, such as proxies and
+// OpenTelemetry-instrumented callers. UUID trace IDs (the NewTraceID format)
+// map one-to-one to the 32-hex traceparent trace-id.
+
+const (
+	// TraceparentHeader is the W3C Trace Context header.
+	TraceparentHeader = "traceparent"
+
+	traceparentVersion = "00"
+	traceparentSampled = "01"
+)
+
+// FormatTraceparent returns a traceparent value for traceID with a fresh span
+// ID. Trace IDs that are not UUIDs or 32-hex strings are hashed so the same ID
+// always maps to the same trace-id.
+func FormatTraceparent(traceID string) string {
+	traceID = strings.TrimSpace(traceID)
+	if traceID == "" {
+		return ""
+	}
+	return traceparentVersion + "-" + w3cTraceID(traceID) + "-" + newSpanID() + "-" + traceparentSampled
+}
+
+// ParseTraceparent extracts the trace ID from a traceparent value, formatted
+// like the IDs NewTraceID returns. It reports false for malformed values.
+func ParseTraceparent(value string) (string, bool) {
+	parts := strings.Split(strings.TrimSpace(value), "-")
+	if len(parts) < 4 {
+		return "", false
+	}
+	version, traceID, spanID, flags := parts[0], parts[1], parts[2], parts[3]
+	// Version ff is forbidden; version 00 has exactly four fields.
+	if !isLowerHex(version, 2) || version == "ff" || (version == traceparentVersion && len(parts) != 4) {
+		return "", false
+	}
+	if !isLowerHex(traceID, 32) || !isLowerHex(spanID, 16) || !isLowerHex(flags, 2) {
+		return "", false
+	}
+	if isAllZero(traceID) || isAllZero(spanID) {
+		return "", false
+	}
+	return traceID[0:8] + "-" + traceID[8:12] + "-" + traceID[12:16] + "-" + traceID[16:20] + "-" + traceID[20:32], true
+}
+
+// w3cTraceID converts a service trace ID to a 32-hex W3C trace-id.
+func w3cTraceID(traceID string) string {
+	if hexID := strings.ToLower(strings.ReplaceAll(traceID, "-", "")); isLowerHex(hexID, 32) && !isAllZero(hexID) {
+		return hexID
+	}
+	sum := sha256.Sum256([]byte(traceID))
+	return hex.EncodeToString(sum[:16])
+}
+
+func newSpanID() string {
+	var b [8]byte
+	if _, err := rand.Read(b[:]); err != nil || isAllZero(hex.EncodeToString(b[:])) {
+		b = [8]byte{0, 0, 0, 0, 0, 0, 0, 1}
+	}
+	return hex.EncodeToString(b[:])
+}
+
+func isLowerHex(s string, n int) bool {
+	if len(s) != n {
+		return false
+	}
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if (c < '0' || c > '9') && (c < 'a' || c > 'f') {
+			return false
+		}
+	}
+	return true
+}
+
+func isAllZero(s string) bool {
+	return strings.Trim(s, "0") == ""
+}
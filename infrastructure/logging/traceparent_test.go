@@ -0,0 +1,63 @@
+package logging
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestTraceparentRoundTrip(t *testing.T) {
+	traceID := NewTraceID()
+
+	header := FormatTraceparent(traceID)
+	parts := strings.Split(header, "-")
+	if len(parts) != 4 || parts[0] != "00" || len(parts[1]) != 32 || len(parts[2]) != 16 || parts[3] != "01" {
+		t.Fatalf("FormatTraceparent() = %q, want 00-<32 hex>-<16 hex>-01", header)
+	}
+
+	got, ok := ParseTraceparent(header)
+	if !ok {
+		t.Fatalf("ParseTraceparent(%q) rejected its own output", header)
+	}
+	if got != traceID {
+		t.Fatalf("ParseTraceparent() = %q, want %q", got, traceID)
+	}
+}
+
+func TestFormatTraceparentNonUUIDTraceID(t *testing.T) {
+	first := FormatTraceparent("trace-123")
+	second := FormatTraceparent("trace-123")
+	if first[:35] != second[:35] {
+		t.Fatalf("trace-id differs for the same trace ID: %q vs %q", first, second)
+	}
+	if first == second {
+		t.Fatal("each traceparent should carry a fresh span ID")
+	}
+	if _, ok := ParseTraceparent(first); !ok {
+		t.Fatalf("ParseTraceparent(%q) = false", first)
+	}
+	if FormatTraceparent("") != "" {
+		t.Fatal("FormatTraceparent(\"\") should be empty")
+	}
+}
+
+func TestParseTraceparentRejectsMalformed(t *testing.T) {
+	tests := []string{
+		"",
+		"00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7",
+		"00-4BF92F3577B34DA6A3CE929D0E0E4736-00f067aa0ba902b7-01",
+		"00-00000000000000000000000000000000-00f067aa0ba902b7-01",
+		"00-4bf92f3577b34da6a3ce929d0e0e4736-0000000000000000-01",
+		"ff-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01",
+		"00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01-extra",
+	}
+	for _, value := range tests {
+		if _, ok := ParseTraceparent(value); ok {
+			t.Errorf("ParseTraceparent(%q) = true, want false", value)
+		}
+	}
+
+	// Future versions may append fields.
+	if _, ok := ParseTraceparent("01-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01-extra"); !ok {
+		t.Error("ParseTraceparent should accept extra fields for future versions")
+	}
+}
@@ -1,6 +1,10 @@
 package marble
 
-import "fmt"
+import (
+	"fmt"
+	"os"
+	"strings"
+)
 
 // RequireSecret loads a secret from the Marble and enforces minimum length.
 // In strict mode (production/enclave), a missing or short secret is a fatal error.
@@ -61,3 +65,64 @@ func (m *Marble) IsStrict() bool {
 	}
 	return m.IsEnclave()
 }
+
+// SecretOrEnv returns the Marble secret name, falling back to the environment
+// variable envKey. Values are whitespace-trimmed; "" means neither is set.
+//
+// This replaces the repeated entry-point pattern:
+//
+//	value := ""
+//	if secret, ok := m.Secret("KEY"); ok && len(secret) > 0 {
+//	    value = strings.TrimSpace(string(secret))
+//	}
+//	if value == "" {
+//	    value = strings.TrimSpace(os.Getenv("KEY"))
+//	}
+func (m *Marble) SecretOrEnv(name, envKey string) string {
+	if m != nil && name != "" {
+		if secret, ok := m.Secret(name); ok {
+			if value := strings.TrimSpace(string(secret)); value != "" {
+				return value
+			}
+		}
+	}
+	if envKey == "" {
+		return ""
+	}
+	return strings.TrimSpace(os.Getenv(envKey))
+}
+
+// SecretBytesOrEnv is SecretOrEnv for binary secrets such as raw keys: the
+// Marble secret is returned as-is, since trimming could drop key bytes. Only
+// the environment fallback is whitespace-trimmed. nil means neither is set.
+func (m *Marble) SecretBytesOrEnv(name, envKey string) []byte {
+	if m != nil && name != "" {
+		if secret, ok := m.Secret(name); ok && len(secret) > 0 {
+			return secret
+		}
+	}
+	if envKey == "" {
+		return nil
+	}
+	if value := strings.TrimSpace(os.Getenv(envKey)); value != "" {
+		return []byte(value)
+	}
+	return nil
+}
+
+// RequireSecretOrEnv is SecretOrEnv for values that must be set in strict
+// (enclave) mode, where a missing value is an error. Outside strict mode a
+// missing value returns ("", nil) so the caller can fall back to
+// development-mode behavior.
+func (m *Marble) RequireSecretOrEnv(name, envKey string) (string, error) {
+	if value := m.SecretOrEnv(name, envKey); value != "" {
+		return value, nil
+	}
+	if m.IsStrict() {
+		if envKey == "" || envKey == name {
+			return "", fmt.Errorf("%s is required", name)
+		}
+		return "", fmt.Errorf("%s is required (secret %s or env %s)", name, name, envKey)
+	}
+	return "", nil
+}
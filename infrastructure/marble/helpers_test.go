@@ -2,6 +2,8 @@ package marble
 
 import (
 	"testing"
+
+	"github.com/edgelesssys/ego/attestation"
 )
 
 func TestRequireSecret(t *testing.T) {
@@ -57,3 +59,58 @@ func TestIsStrict(t *testing.T) {
 		t.Error("non-enclave marble should not be strict")
 	}
 }
+
+func TestSecretOrEnv(t *testing.T) {
+	t.Setenv("TEST_SECRET_OR_ENV_URL", "  https://env.example  ")
+	m := &Marble{secrets: map[string][]byte{
+		"DB_URL": []byte(" https://secret.example\n"),
+		"BLANK":  []byte("  "),
+	}}
+
+	if got := m.SecretOrEnv("DB_URL", "TEST_SECRET_OR_ENV_URL"); got != "https://secret.example" {
+		t.Errorf("secret should win, got %q", got)
+	}
+	if got := m.SecretOrEnv("BLANK", "TEST_SECRET_OR_ENV_URL"); got != "https://env.example" {
+		t.Errorf("blank secret should fall back to env, got %q", got)
+	}
+	var nilMarble *Marble
+	if got := nilMarble.SecretOrEnv("DB_URL", "TEST_SECRET_OR_ENV_URL"); got != "https://env.example" {
+		t.Errorf("nil marble should read env, got %q", got)
+	}
+	if got := m.SecretOrEnv("MISSING", ""); got != "" {
+		t.Errorf("missing value = %q, want empty", got)
+	}
+}
+
+func TestSecretBytesOrEnv(t *testing.T) {
+	t.Setenv("TEST_SECRET_BYTES_KEY", " abcd\n")
+	raw := []byte{0x20, 0x01, 0x0a}
+	m := &Marble{secrets: map[string][]byte{"KEY": raw}}
+
+	if got := m.SecretBytesOrEnv("KEY", "TEST_SECRET_BYTES_KEY"); string(got) != string(raw) {
+		t.Errorf("secret bytes should be returned untrimmed, got %x", got)
+	}
+	if got := m.SecretBytesOrEnv("MISSING", "TEST_SECRET_BYTES_KEY"); string(got) != "abcd" {
+		t.Errorf("env fallback = %q, want trimmed value", got)
+	}
+	if got := m.SecretBytesOrEnv("MISSING", ""); got != nil {
+		t.Errorf("missing value = %x, want nil", got)
+	}
+}
+
+func TestRequireSecretOrEnv(t *testing.T) {
+	m := &Marble{secrets: map[string][]byte{"DB_URL": []byte("https://secret.example")}}
+	if got, err := m.RequireSecretOrEnv("DB_URL", ""); err != nil || got != "https://secret.example" {
+		t.Fatalf("RequireSecretOrEnv() = %q, %v", got, err)
+	}
+
+	// Missing values are tolerated outside strict mode.
+	if got, err := m.RequireSecretOrEnv("TEST_REQUIRE_MISSING", "TEST_REQUIRE_MISSING"); err != nil || got != "" {
+		t.Fatalf("non-strict RequireSecretOrEnv() = %q, %v; want empty, nil", got, err)
+	}
+
+	strict := &Marble{secrets: map[string][]byte{}, report: &attestation.Report{}}
+	if _, err := strict.RequireSecretOrEnv("TEST_REQUIRE_MISSING", "TEST_REQUIRE_MISSING"); err == nil {
+		t.Fatal("strict RequireSecretOrEnv() should fail for a missing value")
+	}
+}
@@ -295,12 +295,17 @@ func (t *traceHeaderRoundTripper) RoundTrip(req *http.Request) (*http.Response,
 	}
 
 	traceID := logging.GetTraceID(req.Context())
-	if traceID == "" || req.Header.Get("X-Trace-ID") != "" {
+	if traceID == "" || (req.Header.Get("X-Trace-ID") != "" && req.Header.Get(logging.TraceparentHeader) != "") {
 		return t.base.RoundTrip(req)
 	}
 
 	clone := req.Clone(req.Context())
-	clone.Header.Set("X-Trace-ID", traceID)
+	if clone.Header.Get("X-Trace-ID") == "" {
+		clone.Header.Set("X-Trace-ID", traceID)
+	}
+	if clone.Header.Get(logging.TraceparentHeader) == "" {
+		clone.Header.Set(logging.TraceparentHeader, logging.FormatTraceparent(traceID))
+	}
 	return t.base.RoundTrip(clone)
 }
 
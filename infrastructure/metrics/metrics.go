@@ -31,9 +31,20 @@ type Metrics struct {
 	DatabaseQueryDuration   *prometheus.HistogramVec
 	DatabaseConnectionsOpen prometheus.Gauge
 
+	// Circuit breaker metrics
+	CircuitBreakerState          *prometheus.GaugeVec
+	CircuitBreakerTransitions    *prometheus.CounterVec
+	CircuitBreakerHalfOpenProbes *prometheus.CounterVec
+
 	// Service health
-	ServiceUptime prometheus.Gauge
-	ServiceInfo   *prometheus.GaugeVec
+	ServiceUptime   prometheus.Gauge
+	ServiceInfo     *prometheus.GaugeVec
+	ServiceDegraded *prometheus.GaugeVec
+	ServiceStalls   *prometheus.CounterVec
+
+	// Chain event subscribers
+	EventSubscriberDropped *prometheus.CounterVec
+	EventSubscriberLag     *prometheus.GaugeVec
 }
 
 // New creates a new Metrics instance with all collectors registered
@@ -116,6 +127,29 @@ func NewWithRegistry(serviceName string, registerer prometheus.Registerer) *Metr
 			},
 		),
 
+		// Circuit breaker metrics
+		CircuitBreakerState: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: "circuit_breaker_state",
+				Help: "Circuit breaker state (0=closed, 1=half-open, 2=open)",
+			},
+			[]string{"service", "breaker"},
+		),
+		CircuitBreakerTransitions: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Name: "circuit_breaker_transitions_total",
+				Help: "Total number of circuit breaker state transitions",
+			},
+			[]string{"service", "breaker", "from", "to"},
+		),
+		CircuitBreakerHalfOpenProbes: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Name: "circuit_breaker_half_open_probes_total",
+				Help: "Total number of calls let through while a circuit breaker was half-open",
+			},
+			[]string{"service", "breaker"},
+		),
+
 		// Service health
 		ServiceUptime: prometheus.NewGauge(
 			prometheus.GaugeOpts{
@@ -130,6 +164,36 @@ func NewWithRegistry(serviceName string, registerer prometheus.Registerer) *Metr
 			},
 			[]string{"service", "version", "environment"},
 		),
+		ServiceDegraded: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: "service_degraded",
+				Help: "Whether the service is running in degraded mode (1) or not (0)",
+			},
+			[]string{"service"},
+		),
+		ServiceStalls: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Name: "service_lifecycle_stalls_total",
+				Help: "Total number of start/stop transitions that exceeded the stall timeout",
+			},
+			[]string{"service", "phase"},
+		),
+
+		// Chain event subscribers
+		EventSubscriberDropped: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Name: "chain_event_subscriber_dropped_total",
+				Help: "Total number of chain events dropped because a subscriber's queue was full",
+			},
+			[]string{"subscriber"},
+		),
+		EventSubscriberLag: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: "chain_event_subscriber_lag",
+				Help: "Number of chain events queued for a subscriber and not yet handled",
+			},
+			[]string{"subscriber"},
+		),
 	}
 
 	// Register all collectors
@@ -144,8 +208,15 @@ func NewWithRegistry(serviceName string, registerer prometheus.Registerer) *Metr
 			m.DatabaseQueriesTotal,
 			m.DatabaseQueryDuration,
 			m.DatabaseConnectionsOpen,
+			m.CircuitBreakerState,
+			m.CircuitBreakerTransitions,
+			m.CircuitBreakerHalfOpenProbes,
 			m.ServiceUptime,
 			m.ServiceInfo,
+			m.ServiceDegraded,
+			m.ServiceStalls,
+			m.EventSubscriberDropped,
+			m.EventSubscriberLag,
 		)
 	}
 
@@ -183,6 +254,47 @@ func (m *Metrics) SetDatabaseConnections(count int) {
 	m.DatabaseConnectionsOpen.Set(float64(count))
 }
 
+// SetCircuitBreakerState sets a circuit breaker's current state
+// (0=closed, 1=half-open, 2=open, matching resilience.State).
+func (m *Metrics) SetCircuitBreakerState(service, breaker string, state int) {
+	m.CircuitBreakerState.WithLabelValues(service, breaker).Set(float64(state))
+}
+
+// RecordCircuitBreakerTransition records a circuit breaker state transition
+func (m *Metrics) RecordCircuitBreakerTransition(service, breaker, from, to string, state int) {
+	m.CircuitBreakerTransitions.WithLabelValues(service, breaker, from, to).Inc()
+	m.SetCircuitBreakerState(service, breaker, state)
+}
+
+// RecordCircuitBreakerProbe records a call admitted while half-open
+func (m *Metrics) RecordCircuitBreakerProbe(service, breaker string) {
+	m.CircuitBreakerHalfOpenProbes.WithLabelValues(service, breaker).Inc()
+}
+
+// SetServiceDegraded records whether a service is running in degraded mode
+func (m *Metrics) SetServiceDegraded(service string, degraded bool) {
+	value := 0.0
+	if degraded {
+		value = 1
+	}
+	m.ServiceDegraded.WithLabelValues(service).Set(value)
+}
+
+// RecordServiceStall records a start/stop transition that stalled
+func (m *Metrics) RecordServiceStall(service, phase string) {
+	m.ServiceStalls.WithLabelValues(service, phase).Inc()
+}
+
+// RecordEventSubscriberDrop records a chain event dropped for a slow subscriber
+func (m *Metrics) RecordEventSubscriberDrop(subscriber string) {
+	m.EventSubscriberDropped.WithLabelValues(subscriber).Inc()
+}
+
+// SetEventSubscriberLag records how many events a subscriber has queued
+func (m *Metrics) SetEventSubscriberLag(subscriber string, queued int) {
+	m.EventSubscriberLag.WithLabelValues(subscriber).Set(float64(queued))
+}
+
 // UpdateUptime updates the service uptime
 func (m *Metrics) UpdateUptime(startTime time.Time) {
 	m.ServiceUptime.Set(time.Since(startTime).Seconds())
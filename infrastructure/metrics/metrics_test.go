@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
 )
 
 func TestNew(t *testing.T) {
@@ -111,3 +112,21 @@ func TestNewWithRegistry(t *testing.T) {
 		t.Error("Expected metrics to be registered")
 	}
 }
+
+func TestRecordCircuitBreakerTransition(t *testing.T) {
+	reg := prometheus.NewRegistry()
+	m := NewWithRegistry("test-service", reg)
+
+	m.RecordCircuitBreakerTransition("test-service", "http", "closed", "open", 2)
+	m.RecordCircuitBreakerProbe("test-service", "http")
+
+	if got := testutil.ToFloat64(m.CircuitBreakerState.WithLabelValues("test-service", "http")); got != 2 {
+		t.Errorf("circuit_breaker_state = %v, want 2", got)
+	}
+	if got := testutil.ToFloat64(m.CircuitBreakerTransitions.WithLabelValues("test-service", "http", "closed", "open")); got != 1 {
+		t.Errorf("circuit_breaker_transitions_total = %v, want 1", got)
+	}
+	if got := testutil.ToFloat64(m.CircuitBreakerHalfOpenProbes.WithLabelValues("test-service", "http")); got != 1 {
+		t.Errorf("circuit_breaker_half_open_probes_total = %v, want 1", got)
+	}
+}
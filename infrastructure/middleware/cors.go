@@ -38,7 +38,7 @@ func NewCORSMiddleware(cfg *CORSConfig) *CORSMiddleware {
 		cfgValue.AllowedMethods = []string{http.MethodGet, http.MethodPost, http.MethodPut, http.MethodDelete, http.MethodOptions}
 	}
 	if len(cfgValue.AllowedHeaders) == 0 {
-		cfgValue.AllowedHeaders = []string{"Content-Type", "Authorization", "X-Trace-ID"}
+		cfgValue.AllowedHeaders = []string{"Content-Type", "Authorization", "X-Trace-ID", "traceparent"}
 	}
 	if len(cfgValue.ExposedHeaders) == 0 {
 		cfgValue.ExposedHeaders = []string{"X-Trace-ID"}
@@ -16,8 +16,12 @@ func LoggingMiddleware(logger *logging.Logger) mux.MiddlewareFunc {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			start := time.Now()
 
-			// Generate or extract trace ID
+			// Generate or extract trace ID. X-Trace-ID wins; a W3C traceparent
+			// from callers that only speak trace context continues their trace.
 			traceID := r.Header.Get("X-Trace-ID")
+			if traceID == "" {
+				traceID, _ = logging.ParseTraceparent(r.Header.Get(logging.TraceparentHeader))
+			}
 			if traceID == "" {
 				traceID = logging.NewTraceID()
 			}
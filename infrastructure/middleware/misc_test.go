@@ -17,6 +17,7 @@ import (
 	"github.com/prometheus/client_golang/prometheus"
 
 	"github.com/R3E-Network/neo-miniapps-platform/infrastructure/logging"
+	"github.com/R3E-Network/neo-miniapps-platform/infrastructure/marble"
 	"github.com/R3E-Network/neo-miniapps-platform/infrastructure/metrics"
 )
 
@@ -125,6 +126,41 @@ func TestLoggingMiddleware_SetsTraceHeaderAndContext(t *testing.T) {
 	}
 }
 
+func TestLoggingMiddleware_TraceparentSurvivesMarbleClientRoundTrip(t *testing.T) {
+	logger := logging.New("test", "error", "text")
+	seen := make(chan string, 1)
+	traced := LoggingMiddleware(logger)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		seen <- logging.GetTraceID(r.Context())
+	}))
+	// Simulate a hop that only forwards W3C trace context.
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get(logging.TraceparentHeader) == "" {
+			t.Errorf("request is missing %s", logging.TraceparentHeader)
+		}
+		r.Header.Del("X-Trace-ID")
+		traced.ServeHTTP(w, r)
+	}))
+	defer server.Close()
+
+	m, err := marble.New(marble.Config{MarbleType: "test"})
+	if err != nil {
+		t.Fatalf("marble.New: %v", err)
+	}
+
+	traceID := logging.NewTraceID()
+	ctx := logging.WithTraceID(context.Background(), traceID)
+	req, _ := http.NewRequestWithContext(ctx, http.MethodGet, server.URL, nil)
+	resp, err := m.HTTPClient().Do(req)
+	if err != nil {
+		t.Fatalf("request failed: %v", err)
+	}
+	resp.Body.Close()
+
+	if got := <-seen; got != traceID {
+		t.Fatalf("server trace ID = %q, want %q", got, traceID)
+	}
+}
+
 func TestTracingMiddleware_GeneratesTraceID(t *testing.T) {
 	logger := logging.New("test", "error", "text")
 	mw := NewTracingMiddleware(logger)
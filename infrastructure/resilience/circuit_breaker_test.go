@@ -73,3 +73,53 @@ func TestCircuitBreaker_RejectsWhenOpen(t *testing.T) {
 		t.Errorf("expected ErrCircuitOpen, got %v", err)
 	}
 }
+
+func TestCircuitBreaker_NamedRegistryAndObserver(t *testing.T) {
+	var transitions []string
+	var probes int
+	RegisterObserver(Observer{
+		OnStateChange: func(name string, from, to State) {
+			if name == "registry-test" {
+				transitions = append(transitions, from.String()+"->"+to.String())
+			}
+		},
+		OnHalfOpenProbe: func(name string) {
+			if name == "registry-test" {
+				probes++
+			}
+		},
+	})
+
+	cb := New(Config{Name: "registry-test", MaxFailures: 1, Timeout: 10 * time.Millisecond, HalfOpenMax: 1})
+	if state, ok := CircuitState("registry-test"); !ok || state != StateClosed {
+		t.Fatalf("CircuitState() = %v, %v; want closed, true", state, ok)
+	}
+
+	cb.Execute(context.Background(), func() error { return errors.New("fail") })
+	if state, _ := CircuitState("registry-test"); state != StateOpen {
+		t.Fatalf("expected open, got %v", state)
+	}
+
+	time.Sleep(20 * time.Millisecond)
+	cb.Execute(context.Background(), func() error { return nil })
+
+	if got := CircuitStates()["registry-test"]; got != StateClosed {
+		t.Fatalf("expected closed after probe, got %v", got)
+	}
+	if cb.HalfOpenProbes() != 1 || probes != 1 {
+		t.Fatalf("expected 1 half-open probe, got %d (observer %d)", cb.HalfOpenProbes(), probes)
+	}
+	want := []string{"closed->open", "open->half-open", "half-open->closed"}
+	if len(transitions) != len(want) {
+		t.Fatalf("transitions = %v, want %v", transitions, want)
+	}
+	for i := range want {
+		if transitions[i] != want[i] {
+			t.Fatalf("transitions = %v, want %v", transitions, want)
+		}
+	}
+
+	if _, ok := CircuitState("unknown"); ok {
+		t.Fatal("unnamed breaker should not be registered")
+	}
+}
@@ -9,6 +9,9 @@ package resilience
 import (
 	"context"
 	"errors"
+	"math/rand"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/cenkalti/backoff/v4"
@@ -58,6 +61,9 @@ var (
 
 // Config for circuit breaker.
 type Config struct {
+	// Name registers the breaker so its state can be queried with CircuitState
+	// and reported to observers (e.g. Prometheus). Unnamed breakers are private.
+	Name          string
 	MaxFailures   int           // consecutive failures before opening
 	Timeout       time.Duration // time in open state before half-open
 	HalfOpenMax   int           // max requests allowed in half-open
@@ -76,7 +82,9 @@ func DefaultConfig() Config {
 // CircuitBreaker wraps gobreaker.CircuitBreaker while preserving the
 // original Execute(ctx, fn) signature used by all consumers.
 type CircuitBreaker struct {
-	gb *gobreaker.CircuitBreaker[any]
+	gb             *gobreaker.CircuitBreaker[any]
+	name           string
+	halfOpenProbes atomic.Uint64
 }
 
 // New creates a new CircuitBreaker backed by sony/gobreaker.
@@ -95,6 +103,7 @@ func New(cfg Config) *CircuitBreaker {
 	halfOpenMax := uint32(cfg.HalfOpenMax)
 
 	settings := gobreaker.Settings{
+		Name:        cfg.Name,
 		MaxRequests: halfOpenMax,
 		Interval:    0, // gobreaker resets counts on state change, not on interval
 		Timeout:     cfg.Timeout,
@@ -103,15 +112,30 @@ func New(cfg Config) *CircuitBreaker {
 		},
 	}
 
-	if cfg.OnStateChange != nil {
+	if cfg.OnStateChange != nil || cfg.Name != "" {
 		settings.OnStateChange = func(name string, from, to gobreaker.State) {
-			cfg.OnStateChange(State(from), State(to))
+			if cfg.OnStateChange != nil {
+				cfg.OnStateChange(State(from), State(to))
+			}
+			if name != "" {
+				notifyStateChange(name, State(from), State(to))
+			}
 		}
 	}
 
-	return &CircuitBreaker{
-		gb: gobreaker.NewCircuitBreaker[any](settings),
+	cb := &CircuitBreaker{
+		gb:   gobreaker.NewCircuitBreaker[any](settings),
+		name: cfg.Name,
 	}
+	if cfg.Name != "" {
+		registerBreaker(cb)
+	}
+	return cb
+}
+
+// Name returns the name the breaker was registered under, if any.
+func (cb *CircuitBreaker) Name() string {
+	return cb.name
 }
 
 // State returns the current circuit breaker state.
@@ -119,11 +143,22 @@ func (cb *CircuitBreaker) State() State {
 	return State(cb.gb.State())
 }
 
+// HalfOpenProbes returns how many calls were let through while half-open.
+func (cb *CircuitBreaker) HalfOpenProbes() uint64 {
+	return cb.halfOpenProbes.Load()
+}
+
 // Execute runs fn with circuit breaker protection.
 // The ctx parameter is accepted for API compatibility but gobreaker does not
 // use it internally — callers should enforce timeouts via context on fn itself.
 func (cb *CircuitBreaker) Execute(_ context.Context, fn func() error) error {
 	_, err := cb.gb.Execute(func() (any, error) {
+		if cb.gb.State() == gobreaker.StateHalfOpen {
+			cb.halfOpenProbes.Add(1)
+			if cb.name != "" {
+				notifyHalfOpenProbe(cb.name)
+			}
+		}
 		return nil, fn()
 	})
 	if err != nil {
@@ -145,6 +180,83 @@ func mapGobreakerError(err error) error {
 	return err
 }
 
+// ---------------------------------------------------------------------------
+// Named breaker registry
+// ---------------------------------------------------------------------------
+
+// Observer receives events from every named circuit breaker. Either field
+// may be nil.
+type Observer struct {
+	OnStateChange   func(name string, from, to State)
+	OnHalfOpenProbe func(name string)
+}
+
+var (
+	registryMu sync.RWMutex
+	breakers   = map[string]*CircuitBreaker{}
+	observers  []Observer
+)
+
+// RegisterObserver subscribes to state changes and half-open probes of all
+// named circuit breakers, including ones created later.
+func RegisterObserver(o Observer) {
+	registryMu.Lock()
+	observers = append(observers, o)
+	registryMu.Unlock()
+}
+
+// CircuitState returns the current state of the named circuit breaker.
+func CircuitState(name string) (State, bool) {
+	registryMu.RLock()
+	cb, ok := breakers[name]
+	registryMu.RUnlock()
+	if !ok {
+		return StateClosed, false
+	}
+	return cb.State(), true
+}
+
+// CircuitStates returns the current state of every named circuit breaker.
+func CircuitStates() map[string]State {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+	states := make(map[string]State, len(breakers))
+	for name, cb := range breakers {
+		states[name] = cb.State()
+	}
+	return states
+}
+
+// registerBreaker adds cb to the registry, replacing an earlier breaker with
+// the same name.
+func registerBreaker(cb *CircuitBreaker) {
+	registryMu.Lock()
+	breakers[cb.name] = cb
+	registryMu.Unlock()
+}
+
+func notifyStateChange(name string, from, to State) {
+	registryMu.RLock()
+	obs := append([]Observer(nil), observers...)
+	registryMu.RUnlock()
+	for _, o := range obs {
+		if o.OnStateChange != nil {
+			o.OnStateChange(name, from, to)
+		}
+	}
+}
+
+func notifyHalfOpenProbe(name string) {
+	registryMu.RLock()
+	obs := append([]Observer(nil), observers...)
+	registryMu.RUnlock()
+	for _, o := range obs {
+		if o.OnHalfOpenProbe != nil {
+			o.OnHalfOpenProbe(name)
+		}
+	}
+}
+
 // ---------------------------------------------------------------------------
 // Retry
 // ---------------------------------------------------------------------------
@@ -156,16 +268,25 @@ type RetryConfig struct {
 	MaxDelay     time.Duration
 	Multiplier   float64
 	Jitter       float64 // 0-1, adds randomness (mapped to backoff.RandomizationFactor)
+
+	// FullJitter picks each delay uniformly from [0, min(MaxDelay,
+	// InitialDelay*Multiplier^attempt)) instead of ±Jitter around it, so many
+	// callers failing together do not retry in lockstep. Jitter is ignored.
+	FullJitter bool
+	// Rand returns a value in [0, 1) for FullJitter. Nil uses math/rand;
+	// tests can inject a deterministic source.
+	Rand func() float64
 }
 
-// DefaultRetryConfig returns sensible defaults.
+// DefaultRetryConfig returns sensible defaults. Delays use full jitter so
+// callers that fail together spread out their retries.
 func DefaultRetryConfig() RetryConfig {
 	return RetryConfig{
 		MaxAttempts:  3,
 		InitialDelay: 100 * time.Millisecond,
 		MaxDelay:     10 * time.Second,
 		Multiplier:   2.0,
-		Jitter:       0.1,
+		FullJitter:   true,
 	}
 }
 
@@ -185,7 +306,7 @@ func Retry(ctx context.Context, cfg RetryConfig, fn func() error) error {
 	if cfg.Multiplier > 0 {
 		bo.Multiplier = cfg.Multiplier
 	}
-	if cfg.Jitter > 0 {
+	if cfg.Jitter > 0 && !cfg.FullJitter {
 		bo.RandomizationFactor = cfg.Jitter
 	} else {
 		bo.RandomizationFactor = 0
@@ -193,10 +314,19 @@ func Retry(ctx context.Context, cfg RetryConfig, fn func() error) error {
 	// Disable the global elapsed-time limit; we control via MaxRetries.
 	bo.MaxElapsedTime = 0
 
+	var strategy backoff.BackOff = bo
+	if cfg.FullJitter {
+		randFn := cfg.Rand
+		if randFn == nil {
+			randFn = rand.Float64
+		}
+		strategy = &fullJitterBackOff{base: bo, rand: randFn}
+	}
+
 	// MaxRetries = MaxAttempts - 1 because the first call is not a "retry".
 	maxRetries := uint64(cfg.MaxAttempts - 1)
 
-	withMax := backoff.WithMaxRetries(bo, maxRetries)
+	withMax := backoff.WithMaxRetries(strategy, maxRetries)
 	withCtx := backoff.WithContext(withMax, ctx)
 
 	return backoff.Retry(func() error {
@@ -204,6 +334,25 @@ func Retry(ctx context.Context, cfg RetryConfig, fn func() error) error {
 	}, withCtx)
 }
 
+// fullJitterBackOff scales each capped exponential delay by a random factor
+// in [0, 1) ("full jitter").
+type fullJitterBackOff struct {
+	base *backoff.ExponentialBackOff
+	rand func() float64
+}
+
+func (b *fullJitterBackOff) NextBackOff() time.Duration {
+	next := b.base.NextBackOff()
+	if next == backoff.Stop {
+		return next
+	}
+	return time.Duration(b.rand() * float64(next))
+}
+
+func (b *fullJitterBackOff) Reset() {
+	b.base.Reset()
+}
+
 // ---------------------------------------------------------------------------
 // Service-level convenience configs (preserved from config.go)
 // ---------------------------------------------------------------------------
@@ -5,6 +5,8 @@ import (
 	"errors"
 	"testing"
 	"time"
+
+	"github.com/cenkalti/backoff/v4"
 )
 
 func TestRetry_Success(t *testing.T) {
@@ -51,3 +53,64 @@ func TestRetry_AllFail(t *testing.T) {
 		t.Errorf("expected testErr, got %v", err)
 	}
 }
+
+func TestRetry_FullJitterDelays(t *testing.T) {
+	cfg := RetryConfig{
+		MaxAttempts:  4,
+		InitialDelay: 10 * time.Millisecond,
+		MaxDelay:     25 * time.Millisecond,
+		Multiplier:   2,
+		Jitter:       0.5, // ignored with FullJitter
+		FullJitter:   true,
+		Rand:         func() float64 { return 0.5 },
+	}
+
+	var last time.Time
+	var gaps []time.Duration
+	_ = Retry(context.Background(), cfg, func() error {
+		now := time.Now()
+		if !last.IsZero() {
+			gaps = append(gaps, now.Sub(last))
+		}
+		last = now
+		return errors.New("fail")
+	})
+
+	// Uncapped delays are 10ms, 20ms, 40ms; capped at 25ms and halved.
+	want := []time.Duration{5 * time.Millisecond, 10 * time.Millisecond, 12500 * time.Microsecond}
+	if len(gaps) != len(want) {
+		t.Fatalf("expected %d retries, got %d", len(want), len(gaps))
+	}
+	for i, w := range want {
+		if gaps[i] < w || gaps[i] > w+20*time.Millisecond {
+			t.Errorf("retry %d waited %s, want ~%s", i+1, gaps[i], w)
+		}
+	}
+}
+
+func TestFullJitterBackOffBounds(t *testing.T) {
+	bo := backoff.NewExponentialBackOff()
+	bo.InitialInterval = 100 * time.Millisecond
+	bo.MaxInterval = time.Second
+	bo.Multiplier = 2
+	bo.RandomizationFactor = 0
+
+	jb := &fullJitterBackOff{base: bo, rand: func() float64 { return 0 }}
+	if got := jb.NextBackOff(); got != 0 {
+		t.Fatalf("rand=0 should give zero delay, got %s", got)
+	}
+
+	jb.Reset()
+	jb.rand = func() float64 { return 0.999 }
+	for i := 0; i < 10; i++ {
+		if got := jb.NextBackOff(); got >= time.Second {
+			t.Fatalf("delay %s exceeds MaxDelay", got)
+		}
+	}
+}
+
+func TestDefaultRetryConfigUsesFullJitter(t *testing.T) {
+	if !DefaultRetryConfig().FullJitter {
+		t.Fatal("default retries should use full jitter")
+	}
+}
@@ -0,0 +1,40 @@
+package secrets
+
+import (
+	"context"
+	"time"
+)
+
+// DefaultExpirySweepInterval is how often RunExpirySweeper deletes expired secrets.
+const DefaultExpirySweepInterval = time.Hour
+
+// SweepExpired deletes every secret whose expiry has passed, with its version
+// history. Expired secrets already fail to resolve; the sweep reclaims storage
+// and makes old values unreadable through GetSecretVersion.
+func (m *Manager) SweepExpired(ctx context.Context) error {
+	repo, ok := m.repo.(VersionedRepository)
+	if !ok {
+		return ErrVersioningUnsupported
+	}
+	return repo.DeleteExpiredSecrets(ctx, time.Now())
+}
+
+// RunExpirySweeper calls SweepExpired every interval until ctx is cancelled.
+func (m *Manager) RunExpirySweeper(ctx context.Context, interval time.Duration) {
+	if interval <= 0 {
+		interval = DefaultExpirySweepInterval
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := m.SweepExpired(ctx); err != nil && ctx.Err() == nil {
+				m.logger.WithContext(ctx).WithError(err).Warn("secrets expiry sweep failed")
+			}
+		}
+	}
+}
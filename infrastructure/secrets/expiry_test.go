@@ -0,0 +1,115 @@
+package secrets
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestManagerExpiredSecretDoesNotResolve(t *testing.T) {
+	repo := newVersionedFakeRepo()
+	manager, err := NewManager(repo, []byte("aabbccddeeff00112233445566778899aabbccddeeff00112233445566778899"))
+	if err != nil {
+		t.Fatalf("NewManager error: %v", err)
+	}
+	ctx := context.Background()
+
+	if _, err := manager.StoreSecretWithTTL(ctx, "user-1", "session", "tok", time.Hour); err != nil {
+		t.Fatalf("StoreSecretWithTTL error: %v", err)
+	}
+	if _, err := manager.StoreSecret(ctx, "user-1", "api_key", "key"); err != nil {
+		t.Fatalf("StoreSecret error: %v", err)
+	}
+
+	if got, err := manager.GetSecretForService(ctx, "user-1", "session", "neocompute", false); err != nil || got != "tok" {
+		t.Fatalf("unexpired secret = %q, %v", got, err)
+	}
+
+	past := time.Now().Add(-time.Minute)
+	repo.secrets["session"].ExpiresAt = &past
+
+	_, err = manager.GetSecretForService(ctx, "user-1", "session", "neocompute", false)
+	if !errors.Is(err, ErrExpired) || !errors.Is(err, ErrNotFound) {
+		t.Fatalf("expired secret err = %v, want ErrExpired matching ErrNotFound", err)
+	}
+
+	list, err := manager.ListSecrets(ctx, "user-1")
+	if err != nil || len(list) != 1 || list[0].Name != "api_key" {
+		t.Fatalf("ListSecrets() = %+v, %v; want only api_key", list, err)
+	}
+
+	if err := manager.SweepExpired(ctx); err != nil {
+		t.Fatalf("SweepExpired error: %v", err)
+	}
+	if _, ok := repo.secrets["session"]; ok {
+		t.Fatal("expired secret should be swept")
+	}
+	if _, ok := repo.secrets["api_key"]; !ok {
+		t.Fatal("unexpired secret should be kept")
+	}
+}
+
+func TestManagerSweepRemovesHistoryAndRollbackRejectsExpired(t *testing.T) {
+	repo := newVersionedFakeRepo()
+	manager, err := NewManager(repo, []byte("aabbccddeeff00112233445566778899aabbccddeeff00112233445566778899"))
+	if err != nil {
+		t.Fatalf("NewManager error: %v", err)
+	}
+	ctx := context.Background()
+
+	for _, value := range []string{"old", "tok"} {
+		if _, err := manager.StoreSecretWithTTL(ctx, "user-1", "session", value, time.Hour); err != nil {
+			t.Fatalf("StoreSecretWithTTL error: %v", err)
+		}
+	}
+	past := time.Now().Add(-time.Minute)
+	repo.secrets["session"].ExpiresAt = &past
+
+	if _, err := manager.RollbackSecret(ctx, "user-1", "session", 1); !errors.Is(err, ErrExpired) {
+		t.Fatalf("rollback of expired secret err = %v, want ErrExpired", err)
+	}
+
+	if err := manager.SweepExpired(ctx); err != nil {
+		t.Fatalf("SweepExpired error: %v", err)
+	}
+	if _, err := manager.GetSecretVersion(ctx, "user-1", "session", 1); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("swept version err = %v, want ErrNotFound", err)
+	}
+	if _, err := manager.RollbackSecret(ctx, "user-1", "session", 1); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("rollback of swept secret err = %v, want ErrNotFound", err)
+	}
+
+	version, err := manager.StoreSecret(ctx, "user-1", "session", "new")
+	if err != nil {
+		t.Fatalf("re-create after sweep error: %v", err)
+	}
+	if version != 1 {
+		t.Fatalf("re-created version = %d, want 1 once the history is gone", version)
+	}
+}
+
+func TestManagerCreateContinuesAfterLeftoverHistory(t *testing.T) {
+	repo := newVersionedFakeRepo()
+	manager, err := NewManager(repo, []byte("aabbccddeeff00112233445566778899aabbccddeeff00112233445566778899"))
+	if err != nil {
+		t.Fatalf("NewManager error: %v", err)
+	}
+	ctx := context.Background()
+
+	for _, value := range []string{"a", "b"} {
+		if _, err := manager.StoreSecret(ctx, "user-1", "api_key", value); err != nil {
+			t.Fatalf("StoreSecret error: %v", err)
+		}
+	}
+	// The secret row is gone but its history was not removed.
+	delete(repo.secrets, "api_key")
+
+	version, err := manager.StoreSecret(ctx, "user-1", "api_key", "c")
+	if err != nil {
+		t.Fatalf("StoreSecret error: %v", err)
+	}
+	if version != 3 {
+		t.Fatalf("version = %d, want 3 to avoid reusing stored versions", version)
+	}
+}
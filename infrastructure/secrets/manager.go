@@ -10,7 +10,9 @@ import (
 	"log"
 	"os"
 	"strings"
+	"time"
 
+	"github.com/R3E-Network/neo-miniapps-platform/infrastructure/logging"
 	secretssupabase "github.com/R3E-Network/neo-miniapps-platform/infrastructure/secrets/supabase"
 )
 
@@ -21,8 +23,10 @@ type Repository interface {
 }
 
 type Manager struct {
-	repo Repository
-	aead cipher.AEAD
+	repo             Repository
+	aead             cipher.AEAD
+	versionRetention int
+	logger           *logging.Logger
 }
 
 func NewManager(repo Repository, rawKey []byte) (*Manager, error) {
@@ -41,7 +45,20 @@ func NewManager(repo Repository, rawKey []byte) (*Manager, error) {
 	if err != nil {
 		return nil, err
 	}
-	return &Manager{repo: repo, aead: aead}, nil
+	return &Manager{
+		repo:             repo,
+		aead:             aead,
+		versionRetention: DefaultVersionRetention,
+		logger:           logging.NewFromEnv("secrets"),
+	}, nil
+}
+
+// SetLogger replaces the logger used for background and housekeeping errors,
+// normally with the owning service's logger.
+func (m *Manager) SetLogger(logger *logging.Logger) {
+	if logger != nil {
+		m.logger = logger
+	}
 }
 
 func (m *Manager) GetSecretForService(ctx context.Context, userID, name, serviceID string, strict bool) (string, error) {
@@ -62,6 +79,10 @@ func (m *Manager) GetSecretForService(ctx context.Context, userID, name, service
 		m.audit(ctx, userID, name, serviceID, false, ErrNotFound)
 		return "", ErrNotFound
 	}
+	if secret.Expired(time.Now()) {
+		m.audit(ctx, userID, name, serviceID, false, ErrExpired)
+		return "", ErrExpired
+	}
 
 	allowed, err := m.repo.GetAllowedServices(ctx, userID, name)
 	if err != nil {
@@ -88,13 +109,17 @@ func (m *Manager) GetSecretForService(ctx context.Context, userID, name, service
 }
 
 func (m *Manager) audit(ctx context.Context, userID, name, serviceID string, success bool, err error) {
+	m.auditAction(ctx, "read", userID, name, serviceID, success, err)
+}
+
+func (m *Manager) auditAction(ctx context.Context, action, userID, name, serviceID string, success bool, err error) {
 	if m.repo == nil {
 		return
 	}
 	logEntry := &secretssupabase.AuditLog{
 		UserID:       userID,
 		SecretName:   name,
-		Action:       "read",
+		Action:       action,
 		ServiceID:    serviceID,
 		Success:      success,
 		ErrorMessage: "",
@@ -5,13 +5,30 @@ import "time"
 
 // Secret represents an encrypted secret.
 type Secret struct {
-	ID             string    `json:"id"`
+	ID             string `json:"id"`
+	UserID         string `json:"user_id"`
+	Name           string `json:"name"`
+	EncryptedValue []byte `json:"encrypted_value"`
+	Version        int    `json:"version"`
+	// ExpiresAt is when the secret stops resolving; nil never expires.
+	ExpiresAt *time.Time `json:"expires_at"`
+	CreatedAt time.Time  `json:"created_at"`
+	UpdatedAt time.Time  `json:"updated_at"`
+}
+
+// Expired reports whether the secret's expiry has passed at now.
+func (s *Secret) Expired(now time.Time) bool {
+	return s != nil && s.ExpiresAt != nil && !now.Before(*s.ExpiresAt)
+}
+
+// SecretVersion is a historical value of a secret, kept for rollback.
+type SecretVersion struct {
+	ID             string    `json:"id,omitempty"`
 	UserID         string    `json:"user_id"`
 	Name           string    `json:"name"`
-	EncryptedValue []byte    `json:"encrypted_value"`
 	Version        int       `json:"version"`
-	CreatedAt      time.Time `json:"created_at"`
-	UpdatedAt      time.Time `json:"updated_at"`
+	EncryptedValue []byte    `json:"encrypted_value"`
+	CreatedAt      time.Time `json:"created_at,omitempty"`
 }
 
 // Policy represents an allowed service for a secret.
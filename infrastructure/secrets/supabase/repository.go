@@ -3,13 +3,17 @@ package supabase
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"strconv"
+	"time"
 
 	"github.com/R3E-Network/neo-miniapps-platform/infrastructure/database"
 )
 
 const (
 	secretsTable  = "secrets"
+	versionsTable = "secret_versions"
 	policiesTable = "secret_policies"
 	auditTable    = "secret_audit_logs"
 )
@@ -22,7 +26,14 @@ type RepositoryInterface interface {
 	GetSecretByName(ctx context.Context, userID, name string) (*Secret, error)
 	CreateSecret(ctx context.Context, secret *Secret) error
 	UpdateSecret(ctx context.Context, secret *Secret) error
+	UpdateSecretIfVersion(ctx context.Context, secret *Secret, expectedVersion int) (bool, error)
 	DeleteSecret(ctx context.Context, userID, name string) error
+	DeleteExpiredSecrets(ctx context.Context, now time.Time) error
+	// Version Operations
+	CreateSecretVersion(ctx context.Context, version *SecretVersion) error
+	GetSecretVersion(ctx context.Context, userID, name string, version int) (*SecretVersion, error)
+	ListSecretVersions(ctx context.Context, userID, name string, limit int) ([]SecretVersion, error)
+	DeleteSecretVersionsBefore(ctx context.Context, userID, name string, version int) error
 	// Policy Operations
 	GetPolicies(ctx context.Context, userID string) ([]Policy, error)
 	CreatePolicy(ctx context.Context, policy *Policy) error
@@ -69,7 +80,19 @@ func (r *Repository) CreateSecret(ctx context.Context, secret *Secret) error {
 	if secret.Name == "" {
 		return fmt.Errorf("secret name cannot be empty")
 	}
-	return database.GenericCreate(r.base, ctx, secretsTable, secret, nil)
+	// id and timestamps are left to column defaults.
+	row := map[string]interface{}{
+		"user_id":         secret.UserID,
+		"name":            secret.Name,
+		"encrypted_value": secret.EncryptedValue,
+		"version":         secret.Version,
+		"expires_at":      secret.ExpiresAt,
+	}
+	_, err := r.base.Request(ctx, "POST", secretsTable, row, "")
+	if err != nil {
+		return fmt.Errorf("create secret: %w", err)
+	}
+	return nil
 }
 
 // GetSecretByName retrieves a secret by user ID and name.
@@ -111,13 +134,52 @@ func (r *Repository) UpdateSecret(ctx context.Context, secret *Secret) error {
 		Eq("name", secret.Name).
 		Build()
 
-	_, err := r.base.Request(ctx, "PATCH", secretsTable, secret, query)
+	_, err := r.base.Request(ctx, "PATCH", secretsTable, secretUpdate(secret), query)
 	if err != nil {
 		return fmt.Errorf("update secret: %w", err)
 	}
 	return nil
 }
 
+// UpdateSecretIfVersion updates a secret only while its stored version is
+// still expectedVersion. Returns false when another writer got there first.
+func (r *Repository) UpdateSecretIfVersion(ctx context.Context, secret *Secret, expectedVersion int) (bool, error) {
+	if secret == nil {
+		return false, fmt.Errorf("secret cannot be nil")
+	}
+	if secret.UserID == "" || secret.Name == "" {
+		return false, fmt.Errorf("user_id and name cannot be empty")
+	}
+
+	query := database.NewQuery().
+		Eq("user_id", secret.UserID).
+		Eq("name", secret.Name).
+		EqInt("version", int64(expectedVersion)).
+		Build()
+
+	data, err := r.base.Request(ctx, "PATCH", secretsTable, secretUpdate(secret), query)
+	if err != nil {
+		return false, fmt.Errorf("update secret: %w", err)
+	}
+
+	var rows []Secret
+	if err := json.Unmarshal(data, &rows); err != nil {
+		return false, fmt.Errorf("unmarshal update response: %w", err)
+	}
+	return len(rows) > 0, nil
+}
+
+// secretUpdate is the PATCH body for a secret: only the mutable columns, so
+// id and created_at are never overwritten.
+func secretUpdate(secret *Secret) map[string]interface{} {
+	return map[string]interface{}{
+		"encrypted_value": secret.EncryptedValue,
+		"version":         secret.Version,
+		"expires_at":      secret.ExpiresAt,
+		"updated_at":      time.Now().UTC(),
+	}
+}
+
 // DeleteSecret deletes a secret by user ID and name.
 func (r *Repository) DeleteSecret(ctx context.Context, userID, name string) error {
 	if userID == "" || name == "" {
@@ -136,6 +198,127 @@ func (r *Repository) DeleteSecret(ctx context.Context, userID, name string) erro
 	return nil
 }
 
+// DeleteExpiredSecrets deletes every secret whose expires_at is at or before
+// now, together with its version history, so expired values cannot be read
+// back through GetSecretVersion. Each secret is deleted only if it is still
+// the expired version, so a secret re-stored during the sweep is kept.
+func (r *Repository) DeleteExpiredSecrets(ctx context.Context, now time.Time) error {
+	query := database.NewQuery().
+		Select("user_id", "name", "version").
+		Lte("expires_at", now.UTC().Format(time.RFC3339)).
+		Build()
+
+	expired, err := database.GenericListWithQuery[Secret](r.base, ctx, secretsTable, query)
+	if err != nil {
+		return fmt.Errorf("list expired secrets: %w", err)
+	}
+
+	for i := range expired {
+		secret := &expired[i]
+		deleteQuery := database.NewQuery().
+			Eq("user_id", secret.UserID).
+			Eq("name", secret.Name).
+			EqInt("version", int64(secret.Version)).
+			Lte("expires_at", now.UTC().Format(time.RFC3339)).
+			Build()
+
+		data, err := r.base.Request(ctx, "DELETE", secretsTable, nil, deleteQuery)
+		if err != nil {
+			return fmt.Errorf("delete expired secret %q: %w", secret.Name, err)
+		}
+		var deleted []Secret
+		if err := json.Unmarshal(data, &deleted); err != nil {
+			return fmt.Errorf("unmarshal delete response: %w", err)
+		}
+		if len(deleted) == 0 {
+			continue
+		}
+
+		if err := r.DeleteSecretVersionsBefore(ctx, secret.UserID, secret.Name, secret.Version+1); err != nil {
+			return fmt.Errorf("delete expired secret versions %q: %w", secret.Name, err)
+		}
+	}
+	return nil
+}
+
+// =============================================================================
+// Version Operations
+// =============================================================================
+
+// CreateSecretVersion records a historical secret value.
+func (r *Repository) CreateSecretVersion(ctx context.Context, version *SecretVersion) error {
+	if version == nil {
+		return fmt.Errorf("secret version cannot be nil")
+	}
+	if version.UserID == "" || version.Name == "" {
+		return fmt.Errorf("user_id and name cannot be empty")
+	}
+	if version.Version < 1 {
+		return fmt.Errorf("version must be positive")
+	}
+	return database.GenericCreate(r.base, ctx, versionsTable, version, nil)
+}
+
+// GetSecretVersion retrieves a specific version of a secret.
+func (r *Repository) GetSecretVersion(ctx context.Context, userID, name string, version int) (*SecretVersion, error) {
+	if userID == "" || name == "" {
+		return nil, fmt.Errorf("user_id and name cannot be empty")
+	}
+
+	query := database.NewQuery().
+		Eq("user_id", userID).
+		Eq("name", name).
+		EqInt("version", int64(version)).
+		Limit(1).
+		Build()
+
+	rows, err := database.GenericListWithQuery[SecretVersion](r.base, ctx, versionsTable, query)
+	if err != nil {
+		return nil, err
+	}
+	if len(rows) == 0 {
+		return nil, nil // Not found
+	}
+	return &rows[0], nil
+}
+
+// ListSecretVersions retrieves a secret's versions, newest first.
+func (r *Repository) ListSecretVersions(ctx context.Context, userID, name string, limit int) ([]SecretVersion, error) {
+	if userID == "" || name == "" {
+		return nil, fmt.Errorf("user_id and name cannot be empty")
+	}
+
+	qb := database.NewQuery().
+		Select("user_id", "name", "version", "created_at").
+		Eq("user_id", userID).
+		Eq("name", name).
+		OrderDesc("version")
+	if limit > 0 {
+		qb.Limit(limit)
+	}
+
+	return database.GenericListWithQuery[SecretVersion](r.base, ctx, versionsTable, qb.Build())
+}
+
+// DeleteSecretVersionsBefore prunes versions older than version.
+func (r *Repository) DeleteSecretVersionsBefore(ctx context.Context, userID, name string, version int) error {
+	if userID == "" || name == "" {
+		return fmt.Errorf("user_id and name cannot be empty")
+	}
+
+	query := database.NewQuery().
+		Eq("user_id", userID).
+		Eq("name", name).
+		Lt("version", strconv.Itoa(version)).
+		Build()
+
+	_, err := r.base.Request(ctx, "DELETE", versionsTable, nil, query)
+	if err != nil {
+		return fmt.Errorf("delete secret versions: %w", err)
+	}
+	return nil
+}
+
 // =============================================================================
 // Policy Operations
 // =============================================================================
@@ -0,0 +1,144 @@
+package supabase
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/R3E-Network/neo-miniapps-platform/infrastructure/database"
+	"github.com/R3E-Network/neo-miniapps-platform/infrastructure/testutil"
+)
+
+type capturedRequest struct {
+	method string
+	query  string
+	body   map[string]interface{}
+}
+
+func newCapturingRepository(t *testing.T, response string) (*Repository, *[]capturedRequest) {
+	t.Helper()
+	var captured []capturedRequest
+	server := testutil.NewHTTPTestServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		raw, _ := io.ReadAll(r.Body)
+		req := capturedRequest{method: r.Method, query: r.URL.RawQuery}
+		if len(raw) > 0 {
+			if err := json.Unmarshal(raw, &req.body); err != nil {
+				t.Errorf("request body is not a JSON object: %s", raw)
+			}
+		}
+		captured = append(captured, req)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = io.WriteString(w, response)
+	}))
+	t.Cleanup(server.Close)
+
+	client, err := database.NewClient(database.Config{URL: server.URL, ServiceKey: "test-api-key"})
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+	return NewRepository(database.NewRepository(client)), &captured
+}
+
+func assertKeys(t *testing.T, body map[string]interface{}, want ...string) {
+	t.Helper()
+	if len(body) != len(want) {
+		t.Fatalf("body keys = %v, want exactly %v", body, want)
+	}
+	for _, key := range want {
+		if _, ok := body[key]; !ok {
+			t.Fatalf("body %v is missing %q", body, key)
+		}
+	}
+}
+
+func TestCreateSecretPayload(t *testing.T) {
+	repo, captured := newCapturingRepository(t, `[]`)
+
+	err := repo.CreateSecret(context.Background(), &Secret{
+		UserID:         "user-1",
+		Name:           "api_key",
+		EncryptedValue: []byte("cipher"),
+		Version:        1,
+	})
+	if err != nil {
+		t.Fatalf("CreateSecret() error = %v", err)
+	}
+
+	req := (*captured)[0]
+	if req.method != http.MethodPost {
+		t.Fatalf("method = %s, want POST", req.method)
+	}
+	assertKeys(t, req.body, "user_id", "name", "encrypted_value", "version", "expires_at")
+}
+
+func TestUpdateSecretIfVersionPayload(t *testing.T) {
+	repo, captured := newCapturingRepository(t, `[{"user_id":"user-1","name":"api_key","version":3}]`)
+	expiresAt := time.Now().Add(time.Hour).UTC()
+
+	updated, err := repo.UpdateSecretIfVersion(context.Background(), &Secret{
+		ID:             "must-not-be-sent",
+		UserID:         "user-1",
+		Name:           "api_key",
+		EncryptedValue: []byte("cipher"),
+		Version:        3,
+		ExpiresAt:      &expiresAt,
+	}, 2)
+	if err != nil {
+		t.Fatalf("UpdateSecretIfVersion() error = %v", err)
+	}
+	if !updated {
+		t.Fatal("UpdateSecretIfVersion() = false, want true")
+	}
+
+	req := (*captured)[0]
+	if req.method != http.MethodPatch {
+		t.Fatalf("method = %s, want PATCH", req.method)
+	}
+	if req.query != "user_id=eq.user-1&name=eq.api_key&version=eq.2" {
+		t.Fatalf("query = %q, want the update conditional on version 2", req.query)
+	}
+	assertKeys(t, req.body, "encrypted_value", "version", "expires_at", "updated_at")
+}
+
+func TestUpdateSecretIfVersionConflict(t *testing.T) {
+	repo, _ := newCapturingRepository(t, `[]`)
+
+	updated, err := repo.UpdateSecretIfVersion(context.Background(), &Secret{UserID: "user-1", Name: "api_key", Version: 3}, 2)
+	if err != nil {
+		t.Fatalf("UpdateSecretIfVersion() error = %v", err)
+	}
+	if updated {
+		t.Fatal("UpdateSecretIfVersion() = true when no row matched the expected version")
+	}
+}
+
+func TestUpdateSecretPayload(t *testing.T) {
+	repo, captured := newCapturingRepository(t, `[]`)
+
+	if err := repo.UpdateSecret(context.Background(), &Secret{UserID: "user-1", Name: "api_key", Version: 2}); err != nil {
+		t.Fatalf("UpdateSecret() error = %v", err)
+	}
+	assertKeys(t, (*captured)[0].body, "encrypted_value", "version", "expires_at", "updated_at")
+}
+
+func TestDeleteExpiredSecretsRemovesHistory(t *testing.T) {
+	repo, captured := newCapturingRepository(t, `[{"user_id":"user-1","name":"session","version":2}]`)
+
+	if err := repo.DeleteExpiredSecrets(context.Background(), time.Now()); err != nil {
+		t.Fatalf("DeleteExpiredSecrets() error = %v", err)
+	}
+
+	var methods []string
+	for _, req := range *captured {
+		methods = append(methods, req.method)
+	}
+	if len(*captured) != 3 || (*captured)[1].method != http.MethodDelete || (*captured)[2].method != http.MethodDelete {
+		t.Fatalf("requests = %v, want list, delete secret, delete versions", methods)
+	}
+	if got := (*captured)[2].query; got != "user_id=eq.user-1&name=eq.session&version=lt.3" {
+		t.Fatalf("version delete query = %q", got)
+	}
+}
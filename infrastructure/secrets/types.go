@@ -3,6 +3,7 @@ package secrets
 import (
 	"context"
 	"errors"
+	"fmt"
 )
 
 // MasterKeyEnv is the shared env var name used by Supabase Edge and enclave
@@ -14,6 +15,9 @@ var (
 	ErrNotFound = errors.New("secret not found")
 	// ErrForbidden indicates the caller's service ID is not allowed to access the secret.
 	ErrForbidden = errors.New("secret access forbidden")
+	// ErrExpired indicates the secret exists but its expires_at has passed.
+	// It matches ErrNotFound with errors.Is so callers can treat both alike.
+	ErrExpired = fmt.Errorf("%w: expired", ErrNotFound)
 	// ErrInvalidCiphertext indicates the stored secret cannot be decrypted.
 	ErrInvalidCiphertext = errors.New("invalid secret ciphertext")
 )
@@ -0,0 +1,243 @@
+package secrets
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	secretssupabase "github.com/R3E-Network/neo-miniapps-platform/infrastructure/secrets/supabase"
+)
+
+// DefaultVersionRetention is how many versions of a secret are kept for rollback.
+const DefaultVersionRetention = 10
+
+// maxStoreAttempts bounds retries when concurrent writers race for a version.
+const maxStoreAttempts = 5
+
+// ErrVersioningUnsupported is returned when the Manager's repository cannot
+// store secrets or their history.
+var ErrVersioningUnsupported = errors.New("secrets: repository does not support versioning")
+
+// ErrVersionConflict is returned when a secret kept changing under concurrent
+// writers and the new version could not be stored.
+var ErrVersionConflict = errors.New("secrets: concurrent update conflict")
+
+// VersionedRepository is the storage needed to write secrets and keep their
+// history. secretssupabase.Repository implements it.
+type VersionedRepository interface {
+	Repository
+	GetSecrets(ctx context.Context, userID string) ([]secretssupabase.Secret, error)
+	CreateSecret(ctx context.Context, secret *secretssupabase.Secret) error
+	UpdateSecretIfVersion(ctx context.Context, secret *secretssupabase.Secret, expectedVersion int) (bool, error)
+	DeleteExpiredSecrets(ctx context.Context, now time.Time) error
+	CreateSecretVersion(ctx context.Context, version *secretssupabase.SecretVersion) error
+	GetSecretVersion(ctx context.Context, userID, name string, version int) (*secretssupabase.SecretVersion, error)
+	ListSecretVersions(ctx context.Context, userID, name string, limit int) ([]secretssupabase.SecretVersion, error)
+	DeleteSecretVersionsBefore(ctx context.Context, userID, name string, version int) error
+}
+
+var _ VersionedRepository = (*secretssupabase.Repository)(nil)
+
+// SecretSummary describes a stored secret without its value.
+type SecretSummary struct {
+	Name      string     `json:"name"`
+	Version   int        `json:"version"`
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+	UpdatedAt time.Time  `json:"updated_at"`
+}
+
+// SetVersionRetention changes how many versions are kept per secret.
+// Values below 1 keep only the current version.
+func (m *Manager) SetVersionRetention(n int) {
+	if n < 1 {
+		n = 1
+	}
+	m.versionRetention = n
+}
+
+// StoreSecret encrypts and stores value as the next version of the secret
+// and returns the new version number. Versions beyond the retention limit
+// are pruned.
+func (m *Manager) StoreSecret(ctx context.Context, userID, name, value string) (int, error) {
+	return m.StoreSecretWithTTL(ctx, userID, name, value, 0)
+}
+
+// StoreSecretWithTTL is StoreSecret for short-lived secrets: once ttl has
+// elapsed the secret stops resolving and is removed by SweepExpired.
+// A ttl <= 0 stores a secret that never expires.
+func (m *Manager) StoreSecretWithTTL(ctx context.Context, userID, name, value string, ttl time.Duration) (int, error) {
+	if userID == "" || name == "" {
+		return 0, fmt.Errorf("secrets: userID and name required")
+	}
+	encrypted, err := m.encryptSecretValue(value)
+	if err != nil {
+		return 0, err
+	}
+	var expiresAt *time.Time
+	if ttl > 0 {
+		at := time.Now().Add(ttl).UTC()
+		expiresAt = &at
+	}
+	return m.storeEncrypted(ctx, "update", userID, name, encrypted, expiresAt)
+}
+
+// GetSecretVersion returns the decrypted value of a specific secret version.
+func (m *Manager) GetSecretVersion(ctx context.Context, userID, name string, version int) (string, error) {
+	repo, ok := m.repo.(VersionedRepository)
+	if !ok {
+		return "", ErrVersioningUnsupported
+	}
+	stored, err := repo.GetSecretVersion(ctx, userID, name, version)
+	if err != nil {
+		return "", err
+	}
+	if stored == nil {
+		return "", ErrNotFound
+	}
+	return m.decryptSecretValue(stored.EncryptedValue)
+}
+
+// RollbackSecret restores the value of toVersion as a new version, so the
+// rollback itself can be undone. The current expiry is kept, so a secret that
+// has been deleted or has expired cannot be rolled back (ErrNotFound /
+// ErrExpired); store it again instead. It returns the new version number.
+func (m *Manager) RollbackSecret(ctx context.Context, userID, name string, toVersion int) (int, error) {
+	repo, ok := m.repo.(VersionedRepository)
+	if !ok {
+		return 0, ErrVersioningUnsupported
+	}
+	stored, err := repo.GetSecretVersion(ctx, userID, name, toVersion)
+	if err != nil {
+		return 0, err
+	}
+	if stored == nil {
+		return 0, ErrNotFound
+	}
+	current, err := repo.GetSecretByName(ctx, userID, name)
+	if err != nil {
+		return 0, err
+	}
+	if current == nil {
+		return 0, ErrNotFound
+	}
+	if current.Expired(time.Now()) {
+		return 0, ErrExpired
+	}
+	return m.storeEncrypted(ctx, "rollback", userID, name, stored.EncryptedValue, current.ExpiresAt)
+}
+
+// ListSecrets returns the user's unexpired secrets with their current version.
+func (m *Manager) ListSecrets(ctx context.Context, userID string) ([]SecretSummary, error) {
+	repo, ok := m.repo.(VersionedRepository)
+	if !ok {
+		return nil, ErrVersioningUnsupported
+	}
+	rows, err := repo.GetSecrets(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	result := make([]SecretSummary, 0, len(rows))
+	for i := range rows {
+		if rows[i].Expired(now) {
+			continue
+		}
+		result = append(result, SecretSummary{
+			Name:      rows[i].Name,
+			Version:   rows[i].Version,
+			ExpiresAt: rows[i].ExpiresAt,
+			UpdatedAt: rows[i].UpdatedAt,
+		})
+	}
+	return result, nil
+}
+
+func (m *Manager) storeEncrypted(ctx context.Context, action, userID, name string, encrypted []byte, expiresAt *time.Time) (int, error) {
+	repo, ok := m.repo.(VersionedRepository)
+	if !ok {
+		return 0, ErrVersioningUnsupported
+	}
+
+	version, action, err := m.writeNextVersion(ctx, repo, action, userID, name, encrypted, expiresAt)
+	if err != nil {
+		m.auditAction(ctx, action, userID, name, "", false, err)
+		return 0, err
+	}
+
+	// The conditional write above made version ours, so its history row
+	// cannot collide with a concurrent writer.
+	if err := repo.CreateSecretVersion(ctx, &secretssupabase.SecretVersion{
+		UserID:         userID,
+		Name:           name,
+		Version:        version,
+		EncryptedValue: encrypted,
+	}); err != nil {
+		m.auditAction(ctx, action, userID, name, "", false, err)
+		return 0, err
+	}
+	m.auditAction(ctx, action, userID, name, "", true, nil)
+
+	if oldest := version - m.versionRetention + 1; oldest > 1 {
+		if err := repo.DeleteSecretVersionsBefore(ctx, userID, name, oldest); err != nil {
+			// Pruning is housekeeping; the new version is already stored.
+			m.logger.WithContext(ctx).WithError(err).WithField("secret", name).Warn("failed to prune secret versions")
+		}
+	}
+	return version, nil
+}
+
+// writeNextVersion stores encrypted as the secret's next version. Updates are
+// conditional on the version that was read, and are retried when another
+// writer moved the secret in between. It returns the stored version and the
+// audit action, which becomes "create" for an update of a missing secret.
+func (m *Manager) writeNextVersion(ctx context.Context, repo VersionedRepository, action, userID, name string, encrypted []byte, expiresAt *time.Time) (int, string, error) {
+	for attempt := 0; attempt < maxStoreAttempts; attempt++ {
+		current, err := repo.GetSecretByName(ctx, userID, name)
+		if err != nil {
+			return 0, action, err
+		}
+
+		secret := &secretssupabase.Secret{
+			UserID:         userID,
+			Name:           name,
+			EncryptedValue: encrypted,
+			ExpiresAt:      expiresAt,
+		}
+
+		if current == nil {
+			if action == "update" {
+				action = "create"
+			}
+			// Continue after any history left by an earlier secret of the
+			// same name, so versions are never reused.
+			latest, err := repo.ListSecretVersions(ctx, userID, name, 1)
+			if err != nil {
+				return 0, action, err
+			}
+			secret.Version = 1
+			if len(latest) > 0 {
+				secret.Version = latest[0].Version + 1
+			}
+			if err := repo.CreateSecret(ctx, secret); err != nil {
+				// A concurrent create wins the unique (user_id, name) key;
+				// retry as an update of its row.
+				if again, getErr := repo.GetSecretByName(ctx, userID, name); getErr == nil && again != nil {
+					continue
+				}
+				return 0, action, err
+			}
+			return secret.Version, action, nil
+		}
+
+		secret.Version = max(current.Version, 1) + 1
+		updated, err := repo.UpdateSecretIfVersion(ctx, secret, current.Version)
+		if err != nil {
+			return 0, action, err
+		}
+		if updated {
+			return secret.Version, action, nil
+		}
+	}
+	return 0, action, ErrVersionConflict
+}
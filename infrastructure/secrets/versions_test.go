@@ -0,0 +1,229 @@
+package secrets
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"testing"
+	"time"
+
+	secretssupabase "github.com/R3E-Network/neo-miniapps-platform/infrastructure/secrets/supabase"
+)
+
+type versionedFakeRepo struct {
+	fakeRepo
+	secrets  map[string]*secretssupabase.Secret
+	versions map[string]map[int][]byte
+	actions  []string
+	// beforeUpdate runs once before the next conditional update, to simulate
+	// a concurrent writer.
+	beforeUpdate func()
+}
+
+func newVersionedFakeRepo() *versionedFakeRepo {
+	return &versionedFakeRepo{
+		secrets:  map[string]*secretssupabase.Secret{},
+		versions: map[string]map[int][]byte{},
+	}
+}
+
+func (f *versionedFakeRepo) GetSecretByName(_ context.Context, _, name string) (*secretssupabase.Secret, error) {
+	return f.secrets[name], nil
+}
+
+func (f *versionedFakeRepo) GetSecrets(_ context.Context, _ string) ([]secretssupabase.Secret, error) {
+	out := make([]secretssupabase.Secret, 0, len(f.secrets))
+	for _, s := range f.secrets {
+		out = append(out, *s)
+	}
+	return out, nil
+}
+
+func (f *versionedFakeRepo) CreateSecret(_ context.Context, s *secretssupabase.Secret) error {
+	f.secrets[s.Name] = s
+	return nil
+}
+
+func (f *versionedFakeRepo) UpdateSecretIfVersion(_ context.Context, s *secretssupabase.Secret, expectedVersion int) (bool, error) {
+	if f.beforeUpdate != nil {
+		hook := f.beforeUpdate
+		f.beforeUpdate = nil
+		hook()
+	}
+	current := f.secrets[s.Name]
+	if current == nil || current.Version != expectedVersion {
+		return false, nil
+	}
+	f.secrets[s.Name] = s
+	return true, nil
+}
+
+func (f *versionedFakeRepo) DeleteExpiredSecrets(_ context.Context, now time.Time) error {
+	for name, s := range f.secrets {
+		if s.Expired(now) {
+			delete(f.secrets, name)
+			delete(f.versions, name)
+		}
+	}
+	return nil
+}
+
+func (f *versionedFakeRepo) CreateSecretVersion(_ context.Context, v *secretssupabase.SecretVersion) error {
+	if f.versions[v.Name] == nil {
+		f.versions[v.Name] = map[int][]byte{}
+	}
+	f.versions[v.Name][v.Version] = v.EncryptedValue
+	return nil
+}
+
+func (f *versionedFakeRepo) GetSecretVersion(_ context.Context, userID, name string, version int) (*secretssupabase.SecretVersion, error) {
+	raw, ok := f.versions[name][version]
+	if !ok {
+		return nil, nil
+	}
+	return &secretssupabase.SecretVersion{UserID: userID, Name: name, Version: version, EncryptedValue: raw}, nil
+}
+
+func (f *versionedFakeRepo) ListSecretVersions(_ context.Context, userID, name string, limit int) ([]secretssupabase.SecretVersion, error) {
+	var out []secretssupabase.SecretVersion
+	for v := range f.versions[name] {
+		out = append(out, secretssupabase.SecretVersion{UserID: userID, Name: name, Version: v})
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Version > out[j].Version })
+	if limit > 0 && len(out) > limit {
+		out = out[:limit]
+	}
+	return out, nil
+}
+
+func (f *versionedFakeRepo) DeleteSecretVersionsBefore(_ context.Context, _, name string, version int) error {
+	for v := range f.versions[name] {
+		if v < version {
+			delete(f.versions[name], v)
+		}
+	}
+	return nil
+}
+
+func (f *versionedFakeRepo) CreateAuditLog(_ context.Context, log *secretssupabase.AuditLog) error {
+	f.actions = append(f.actions, log.Action)
+	return nil
+}
+
+func TestManagerStoreSecretVersionsAndRollback(t *testing.T) {
+	repo := newVersionedFakeRepo()
+	manager, err := NewManager(repo, []byte("aabbccddeeff00112233445566778899aabbccddeeff00112233445566778899"))
+	if err != nil {
+		t.Fatalf("NewManager error: %v", err)
+	}
+	ctx := context.Background()
+
+	for i, value := range []string{"v1", "v2", "bad-rotation"} {
+		version, err := manager.StoreSecret(ctx, "user-1", "api_key", value)
+		if err != nil {
+			t.Fatalf("StoreSecret(%q) error: %v", value, err)
+		}
+		if version != i+1 {
+			t.Fatalf("StoreSecret(%q) version = %d, want %d", value, version, i+1)
+		}
+	}
+
+	if got, err := manager.GetSecretVersion(ctx, "user-1", "api_key", 2); err != nil || got != "v2" {
+		t.Fatalf("GetSecretVersion(2) = %q, %v; want v2", got, err)
+	}
+
+	version, err := manager.RollbackSecret(ctx, "user-1", "api_key", 2)
+	if err != nil {
+		t.Fatalf("RollbackSecret error: %v", err)
+	}
+	if version != 4 {
+		t.Fatalf("rollback version = %d, want 4", version)
+	}
+	current, _ := manager.decryptSecretValue(repo.secrets["api_key"].EncryptedValue)
+	if current != "v2" {
+		t.Fatalf("current value after rollback = %q, want v2", current)
+	}
+
+	list, err := manager.ListSecrets(ctx, "user-1")
+	if err != nil || len(list) != 1 || list[0].Version != 4 {
+		t.Fatalf("ListSecrets() = %+v, %v", list, err)
+	}
+
+	want := []string{"create", "update", "update", "rollback"}
+	if len(repo.actions) != len(want) {
+		t.Fatalf("audit actions = %v, want %v", repo.actions, want)
+	}
+	for i := range want {
+		if repo.actions[i] != want[i] {
+			t.Fatalf("audit actions = %v, want %v", repo.actions, want)
+		}
+	}
+
+	if _, err := manager.RollbackSecret(ctx, "user-1", "api_key", 99); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("rollback to missing version err = %v, want ErrNotFound", err)
+	}
+}
+
+func TestManagerPrunesOldVersions(t *testing.T) {
+	repo := newVersionedFakeRepo()
+	manager, err := NewManager(repo, []byte("aabbccddeeff00112233445566778899aabbccddeeff00112233445566778899"))
+	if err != nil {
+		t.Fatalf("NewManager error: %v", err)
+	}
+	manager.SetVersionRetention(2)
+
+	for _, value := range []string{"a", "b", "c", "d"} {
+		if _, err := manager.StoreSecret(context.Background(), "user-1", "token", value); err != nil {
+			t.Fatalf("StoreSecret error: %v", err)
+		}
+	}
+
+	if len(repo.versions["token"]) != 2 {
+		t.Fatalf("kept %d versions, want 2", len(repo.versions["token"]))
+	}
+	if _, err := manager.GetSecretVersion(context.Background(), "user-1", "token", 2); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("pruned version err = %v, want ErrNotFound", err)
+	}
+}
+
+func TestManagerVersioningUnsupported(t *testing.T) {
+	manager, err := NewManager(&fakeRepo{}, []byte("aabbccddeeff00112233445566778899aabbccddeeff00112233445566778899"))
+	if err != nil {
+		t.Fatalf("NewManager error: %v", err)
+	}
+	if _, err := manager.StoreSecret(context.Background(), "user-1", "x", "v"); !errors.Is(err, ErrVersioningUnsupported) {
+		t.Fatalf("err = %v, want ErrVersioningUnsupported", err)
+	}
+}
+
+func TestManagerStoreSecretRetriesOnVersionConflict(t *testing.T) {
+	repo := newVersionedFakeRepo()
+	manager, err := NewManager(repo, []byte("aabbccddeeff00112233445566778899aabbccddeeff00112233445566778899"))
+	if err != nil {
+		t.Fatalf("NewManager error: %v", err)
+	}
+	ctx := context.Background()
+
+	if _, err := manager.StoreSecret(ctx, "user-1", "api_key", "v1"); err != nil {
+		t.Fatalf("StoreSecret error: %v", err)
+	}
+
+	// Another writer stores version 2 between our read and our write.
+	repo.beforeUpdate = func() {
+		if _, err := manager.StoreSecret(ctx, "user-1", "api_key", "concurrent"); err != nil {
+			t.Errorf("concurrent StoreSecret error: %v", err)
+		}
+	}
+	version, err := manager.StoreSecret(ctx, "user-1", "api_key", "ours")
+	if err != nil {
+		t.Fatalf("StoreSecret error: %v", err)
+	}
+	if version != 3 {
+		t.Fatalf("version = %d, want 3 after retrying past the concurrent write", version)
+	}
+	for v, want := range map[int]string{2: "concurrent", 3: "ours"} {
+		if got, err := manager.GetSecretVersion(ctx, "user-1", "api_key", v); err != nil || got != want {
+			t.Fatalf("GetSecretVersion(%d) = %q, %v; want %q", v, got, err, want)
+		}
+	}
+}
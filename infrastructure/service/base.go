@@ -3,6 +3,7 @@ package service
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"os"
 	"sync"
 	"time"
@@ -10,10 +11,15 @@ import (
 	"github.com/R3E-Network/neo-miniapps-platform/infrastructure/database"
 	"github.com/R3E-Network/neo-miniapps-platform/infrastructure/logging"
 	"github.com/R3E-Network/neo-miniapps-platform/infrastructure/marble"
+	"github.com/R3E-Network/neo-miniapps-platform/infrastructure/runtime"
 )
 
 const healthCheckTimeout = 5 * time.Second
 
+// DefaultHealthCacheTTL is how long HealthStatus reuses the last dependency
+// probe, so frequent /health and /ready polling does not ping the DB each time.
+const DefaultHealthCacheTTL = 2 * time.Second
+
 var defaultDBSecrets = []string{"SUPABASE_URL", "SUPABASE_SERVICE_KEY"}
 
 // BaseConfig contains shared configuration for all marbles.
@@ -26,6 +32,9 @@ type BaseConfig struct {
 	Logger  *logging.Logger
 	// RequiredSecrets defines secrets that must be present for the service to be healthy.
 	RequiredSecrets []string
+	// HealthCacheTTL bounds how long a health probe result is reused.
+	// Zero uses HEALTH_CACHE_TTL or DefaultHealthCacheTTL; negative disables caching.
+	HealthCacheTTL time.Duration
 }
 
 // BaseService wraps marble.Service with hydrate/worker wiring and stop handling.
@@ -43,8 +52,9 @@ type BaseService struct {
 	wg       sync.WaitGroup // tracks running worker goroutines for graceful shutdown
 
 	// Extensibility hooks
-	hydrate func(context.Context) error
-	statsFn func() map[string]any
+	hydrate       func(context.Context) error
+	statsFn       func() map[string]any
+	degradedRetry time.Duration // > 0 enables degraded mode (see degraded.go)
 
 	// Worker management
 	workers []func(context.Context)
@@ -56,6 +66,29 @@ type BaseService struct {
 	secretsLoaded   bool
 	lastHealthCheck time.Time
 	startTime       time.Time
+	healthCacheTTL  time.Duration
+	healthCheckMu   sync.Mutex // serializes probes so concurrent callers share one
+
+	// Lifecycle state reported by Describe
+	stateMu        sync.RWMutex
+	state          LifecycleState
+	stateChangedAt time.Time
+	lastErr        string
+	restarts       int
+	stallTimeout   time.Duration // see watchdog.go
+	stallPolicy    StallPolicy
+
+	// Feature flags (see features.go)
+	featureMu        sync.RWMutex
+	featureDefaults  map[string]bool
+	featureOverrides map[string]bool
+
+	// In-process topics (see pubsub.go)
+	topics *PubSub
+
+	// Published service token keys (see jwks.go)
+	jwksMu sync.RWMutex
+	jwks   http.HandlerFunc
 
 	logger *logging.Logger
 }
@@ -90,9 +123,13 @@ func NewBase(cfg *BaseConfig) *BaseService {
 			DB:      cfgValue.DB,
 		}),
 		stopCh:          make(chan struct{}),
+		topics:          NewPubSub(),
 		requiredSecrets: requiredSecrets,
 		dbHealthy:       cfgValue.DB == nil,
 		secretsLoaded:   len(requiredSecrets) == 0,
+		healthCacheTTL:  resolveHealthCacheTTL(cfgValue.HealthCacheTTL),
+		stallTimeout:    DefaultStallTimeout,
+		stallPolicy:     DefaultStallPolicy,
 		logger:          logger,
 	}
 }
@@ -235,7 +272,9 @@ func (b *BaseService) StopChan() <-chan struct{} {
 
 // Start starts the underlying marble.Service, runs hydrate once, then spins workers.
 func (b *BaseService) Start(ctx context.Context) error {
+	b.setState(StateStarting, nil)
 	if err := b.Service.Start(ctx); err != nil {
+		b.setState(StateFailed, err)
 		return err
 	}
 
@@ -247,10 +286,24 @@ func (b *BaseService) Start(ctx context.Context) error {
 
 	if b.hydrate != nil {
 		if err := b.hydrate(ctx); err != nil {
-			return fmt.Errorf("hydrate: %w", err)
+			err = fmt.Errorf("hydrate: %w", err)
+			if b.degradedRetry > 0 {
+				b.enterDegraded(ctx, err)
+				return nil
+			}
+			// Release the underlying service so Start can be retried.
+			_ = b.Service.Stop()
+			b.setState(StateFailed, err)
+			return err
 		}
 	}
 
+	b.startWorkers(ctx)
+	b.setState(StateRunning, nil)
+	return nil
+}
+
+func (b *BaseService) startWorkers(ctx context.Context) {
 	for _, w := range b.workers {
 		worker := w
 		b.wg.Add(1)
@@ -259,18 +312,26 @@ func (b *BaseService) Start(ctx context.Context) error {
 			worker(ctx)
 		}()
 	}
-	return nil
 }
 
 // Stop signals workers and stops the underlying marble.Service.
 // This method is idempotent - calling it multiple times is safe due to sync.Once.
 // It waits for all background workers to finish before stopping the underlying service.
 func (b *BaseService) Stop() error {
+	b.setState(StateStopping, nil)
 	b.stopOnce.Do(func() {
 		close(b.stopCh)
 	})
 	b.wg.Wait()
-	return b.Service.Stop()
+	if b.topics != nil {
+		b.topics.Close()
+	}
+	if err := b.Service.Stop(); err != nil {
+		b.MarkStopFailed(err)
+		return err
+	}
+	b.setState(StateStopped, nil)
+	return nil
 }
 
 // WorkerCount returns the number of registered workers.
@@ -321,13 +382,52 @@ func (b *BaseService) CheckHealth() {
 }
 
 // HealthStatus returns the aggregated health status string.
+// Dependencies are re-probed only when the last check is older than the
+// health cache TTL; call CheckHealth first to force a fresh probe.
 func (b *BaseService) HealthStatus() string {
-	b.CheckHealth()
+	b.healthCheckMu.Lock()
+	if !b.healthFresh() {
+		b.CheckHealth()
+	}
+	b.healthCheckMu.Unlock()
+
 	b.healthMu.RLock()
 	defer b.healthMu.RUnlock()
 	return b.healthStatusLocked()
 }
 
+// SetHealthCacheTTL changes how long health probe results are reused.
+// A value <= 0 disables caching.
+func (b *BaseService) SetHealthCacheTTL(ttl time.Duration) {
+	if ttl < 0 {
+		ttl = 0
+	}
+	b.healthMu.Lock()
+	b.healthCacheTTL = ttl
+	b.healthMu.Unlock()
+}
+
+func (b *BaseService) healthFresh() bool {
+	b.healthMu.RLock()
+	defer b.healthMu.RUnlock()
+	return b.healthCacheTTL > 0 && !b.lastHealthCheck.IsZero() &&
+		time.Since(b.lastHealthCheck) < b.healthCacheTTL
+}
+
+func resolveHealthCacheTTL(cfgValue time.Duration) time.Duration {
+	if cfgValue < 0 {
+		return 0
+	}
+	if cfgValue > 0 {
+		return cfgValue
+	}
+	// Unlike runtime.ResolveDuration, an explicit "0" here disables the cache.
+	if parsed, ok := runtime.ParseEnvDuration("HEALTH_CACHE_TTL"); ok && parsed >= 0 {
+		return parsed
+	}
+	return DefaultHealthCacheTTL
+}
+
 // HealthDetails returns a map describing the most recent health state.
 func (b *BaseService) HealthDetails() map[string]any {
 	b.healthMu.RLock()
@@ -351,10 +451,32 @@ func (b *BaseService) HealthDetails() map[string]any {
 	}
 	details["uptime"] = uptime.String()
 
+	if b.IsDegraded() {
+		details["degraded"] = true
+		b.stateMu.RLock()
+		details["degraded_reason"] = b.lastErr
+		b.stateMu.RUnlock()
+	}
+
+	if state, elapsed, stalled := b.Stalled(); stalled {
+		details["stalled"] = true
+		details["stalled_state"] = string(state)
+		details["stalled_for"] = elapsed.Round(time.Second).String()
+	}
+
 	return details
 }
 
 func (b *BaseService) healthStatusLocked() string {
+	// A start or stop stuck past the stall timeout will not recover by itself.
+	if _, _, stalled := b.Stalled(); stalled {
+		return "unhealthy"
+	}
+	// A service that opted into degraded mode is serving without its
+	// dependency; report that rather than unhealthy.
+	if b.IsDegraded() {
+		return "degraded"
+	}
 	if b.DB() != nil && !b.dbHealthy {
 		return "unhealthy"
 	}
@@ -0,0 +1,91 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	slmetrics "github.com/R3E-Network/neo-miniapps-platform/infrastructure/metrics"
+)
+
+// =============================================================================
+// Degraded Mode
+// =============================================================================
+
+// DefaultDegradedRetryInterval is how often a degraded service retries hydrate.
+const DefaultDegradedRetryInterval = 30 * time.Second
+
+// WithDegradedMode lets Start succeed when hydrate fails. The service then
+// reports StateDegraded, keeps serving HTTP routes, and retries hydrate every
+// retryInterval (DefaultDegradedRetryInterval when <= 0). Background workers
+// start only once hydrate succeeds.
+//
+// Use this for services whose hydrate dependency (usually the database) is
+// not critical to their read paths.
+func (b *BaseService) WithDegradedMode(retryInterval time.Duration) *BaseService {
+	if retryInterval <= 0 {
+		retryInterval = DefaultDegradedRetryInterval
+	}
+	b.degradedRetry = retryInterval
+	return b
+}
+
+// IsDegraded reports whether the service is running in degraded mode.
+func (b *BaseService) IsDegraded() bool {
+	return b.State() == StateDegraded
+}
+
+// enterDegraded marks the service degraded after a hydrate failure and starts
+// retrying hydrate in the background.
+func (b *BaseService) enterDegraded(ctx context.Context, err error) {
+	b.setState(StateDegraded, err)
+	b.publishDegraded(true)
+	b.Logger().WithContext(ctx).WithError(err).WithFields(map[string]interface{}{
+		"retry_interval": b.degradedRetry.String(),
+	}).Warn("hydrate failed; starting in degraded mode")
+
+	b.wg.Add(1)
+	go func() {
+		defer b.wg.Done()
+		b.retryHydrate(ctx)
+	}()
+}
+
+func (b *BaseService) retryHydrate(ctx context.Context) {
+	ticker := time.NewTicker(b.degradedRetry)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-b.stopCh:
+			return
+		case <-ticker.C:
+		}
+
+		if err := b.hydrate(ctx); err != nil {
+			b.setState(StateDegraded, fmt.Errorf("hydrate: %w", err))
+			b.Logger().WithContext(ctx).WithError(err).Debug("degraded mode: hydrate retry failed")
+			continue
+		}
+
+		select {
+		case <-b.stopCh:
+			return
+		default:
+		}
+
+		b.startWorkers(ctx)
+		b.setState(StateRunning, nil)
+		b.publishDegraded(false)
+		b.Logger().WithContext(ctx).Info("hydrate succeeded; leaving degraded mode")
+		return
+	}
+}
+
+func (b *BaseService) publishDegraded(degraded bool) {
+	if slmetrics.Enabled() {
+		slmetrics.Global().SetServiceDegraded(b.ID(), degraded)
+	}
+}
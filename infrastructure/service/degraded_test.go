@@ -0,0 +1,97 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/R3E-Network/neo-miniapps-platform/infrastructure/marble"
+)
+
+func TestDegradedModeRetriesHydrate(t *testing.T) {
+	var calls atomic.Int32
+	var workerStarted atomic.Bool
+
+	m, err := marble.New(marble.Config{MarbleType: "svc"})
+	if err != nil {
+		t.Fatalf("marble.New() error = %v", err)
+	}
+	svc := NewBase(&BaseConfig{ID: "svc", Name: "Service", Marble: m})
+	svc.WithHydrate(func(context.Context) error {
+		if calls.Add(1) < 3 {
+			return errors.New("db down")
+		}
+		return nil
+	})
+	svc.WithDegradedMode(10 * time.Millisecond)
+	svc.AddWorker(func(ctx context.Context) {
+		workerStarted.Store(true)
+		<-svc.StopChan()
+	})
+	svc.RegisterStandardRoutes()
+	defer svc.Stop()
+
+	if err := svc.Start(context.Background()); err != nil {
+		t.Fatalf("Start() error = %v, want degraded start", err)
+	}
+	if !svc.IsDegraded() || workerStarted.Load() {
+		t.Fatalf("state = %q, worker started = %v; want degraded without workers", svc.State(), workerStarted.Load())
+	}
+	if got := svc.HealthStatus(); got != "degraded" {
+		t.Fatalf("HealthStatus() = %q, want degraded", got)
+	}
+	if details := svc.HealthDetails(); details["degraded"] != true || details["degraded_reason"] != "hydrate: db down" {
+		t.Fatalf("HealthDetails() = %v", details)
+	}
+
+	rec := httptest.NewRecorder()
+	svc.Router().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/ready", nil))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("/ready status = %d, want %d while degraded", rec.Code, http.StatusOK)
+	}
+
+	deadline := time.Now().Add(2 * time.Second)
+	for svc.State() != StateRunning && time.Now().Before(deadline) {
+		time.Sleep(5 * time.Millisecond)
+	}
+	if svc.State() != StateRunning {
+		t.Fatalf("state = %q, want %q after hydrate recovers", svc.State(), StateRunning)
+	}
+	if got := calls.Load(); got != 3 {
+		t.Fatalf("hydrate calls = %d, want 3", got)
+	}
+	deadline = time.Now().Add(time.Second)
+	for !workerStarted.Load() && time.Now().Before(deadline) {
+		time.Sleep(5 * time.Millisecond)
+	}
+	if !workerStarted.Load() {
+		t.Fatal("workers should start after leaving degraded mode")
+	}
+}
+
+func TestDegradedModeStopsRetryingOnStop(t *testing.T) {
+	svc := NewBase(&BaseConfig{ID: "svc"})
+	svc.WithHydrate(func(context.Context) error { return errors.New("db down") })
+	svc.WithDegradedMode(time.Hour)
+
+	if err := svc.Start(context.Background()); err != nil {
+		t.Fatalf("Start() error = %v", err)
+	}
+	done := make(chan struct{})
+	go func() {
+		_ = svc.Stop()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(2 * time.Second):
+		t.Fatal("Stop() should not wait for the next hydrate retry")
+	}
+	if svc.State() != StateStopped {
+		t.Fatalf("state = %q, want %q", svc.State(), StateStopped)
+	}
+}
@@ -0,0 +1,197 @@
+package service
+
+import (
+	"net/http"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/gorilla/mux"
+
+	"github.com/R3E-Network/neo-miniapps-platform/infrastructure/httputil"
+	"github.com/R3E-Network/neo-miniapps-platform/infrastructure/runtime"
+)
+
+// =============================================================================
+// Feature Flags
+// =============================================================================
+
+// Feature flags gate optional service behaviour and are resolved on every call,
+// so an override takes effect without restarting workers. Resolution order:
+//  1. an override set with SetFeature
+//  2. env var <SERVICE_ID>_FEATURE_<NAME> (service-scoped)
+//  3. env var FEATURE_<NAME> (global)
+//  4. the default registered with WithFeature (false when unregistered)
+
+// WithFeature registers a feature flag with its default value.
+func (b *BaseService) WithFeature(name string, defaultEnabled bool) *BaseService {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return b
+	}
+	b.featureMu.Lock()
+	if b.featureDefaults == nil {
+		b.featureDefaults = make(map[string]bool)
+	}
+	b.featureDefaults[name] = defaultEnabled
+	b.featureMu.Unlock()
+	return b
+}
+
+// SetFeature overrides a feature flag until ClearFeature is called.
+func (b *BaseService) SetFeature(name string, enabled bool) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return
+	}
+	b.featureMu.Lock()
+	if b.featureOverrides == nil {
+		b.featureOverrides = make(map[string]bool)
+	}
+	b.featureOverrides[name] = enabled
+	b.featureMu.Unlock()
+}
+
+// ClearFeature removes an override so the flag resolves from env/defaults again.
+func (b *BaseService) ClearFeature(name string) {
+	b.featureMu.Lock()
+	delete(b.featureOverrides, strings.TrimSpace(name))
+	b.featureMu.Unlock()
+}
+
+// FeatureEnabled reports whether the named feature is enabled.
+func (b *BaseService) FeatureEnabled(name string) bool {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return false
+	}
+
+	b.featureMu.RLock()
+	override, overridden := b.featureOverrides[name]
+	def := b.featureDefaults[name]
+	b.featureMu.RUnlock()
+	if overridden {
+		return override
+	}
+
+	key := "FEATURE_" + envToken(name)
+	if id := b.ID(); id != "" {
+		if raw := strings.TrimSpace(os.Getenv(envToken(id) + "_" + key)); raw != "" {
+			return runtime.ParseBoolValue(raw)
+		}
+	}
+	if raw := strings.TrimSpace(os.Getenv(key)); raw != "" {
+		return runtime.ParseBoolValue(raw)
+	}
+	return def
+}
+
+// Features returns the resolved value of every registered or overridden flag.
+func (b *BaseService) Features() map[string]bool {
+	b.featureMu.RLock()
+	names := make([]string, 0, len(b.featureDefaults)+len(b.featureOverrides))
+	for name := range b.featureDefaults {
+		names = append(names, name)
+	}
+	for name := range b.featureOverrides {
+		if _, ok := b.featureDefaults[name]; !ok {
+			names = append(names, name)
+		}
+	}
+	b.featureMu.RUnlock()
+	sort.Strings(names)
+
+	result := make(map[string]bool, len(names))
+	for _, name := range names {
+		result[name] = b.FeatureEnabled(name)
+	}
+	return result
+}
+
+// featuresAdminPath is where FeaturesAdminHandler is mounted.
+const featuresAdminPath = "/admin/features"
+
+// FeatureOverrideRequest is the body of PUT /admin/features/{name}.
+type FeatureOverrideRequest struct {
+	Enabled *bool `json:"enabled"`
+}
+
+// FeaturesAdminHandler serves runtime flag overrides to operators (an
+// authenticated service or an admin user):
+//
+//	GET    /admin/features         resolved flags
+//	PUT    /admin/features/{name}  {"enabled": bool} sets an override
+//	DELETE /admin/features/{name}  clears the override
+//
+// Every call responds with the resolved flags.
+func FeaturesAdminHandler(b *BaseService) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if !requireOperator(w, r) {
+			return
+		}
+
+		name, ok := featureName(r)
+		if !ok {
+			httputil.NotFound(w, "not found")
+			return
+		}
+		switch {
+		case name == "" && r.Method == http.MethodGet:
+		case name != "" && r.Method == http.MethodPut:
+			var req FeatureOverrideRequest
+			if !httputil.DecodeJSON(w, r, &req) {
+				return
+			}
+			if req.Enabled == nil {
+				httputil.BadRequest(w, "enabled required")
+				return
+			}
+			b.SetFeature(name, *req.Enabled)
+			b.Logger().WithContext(r.Context()).WithFields(map[string]interface{}{
+				"feature": name,
+				"enabled": *req.Enabled,
+			}).Info("feature flag overridden")
+		case name != "" && r.Method == http.MethodDelete:
+			b.ClearFeature(name)
+			b.Logger().WithContext(r.Context()).WithField("feature", name).Info("feature flag override cleared")
+		default:
+			httputil.WriteError(w, http.StatusMethodNotAllowed, "method not allowed")
+			return
+		}
+		httputil.WriteJSON(w, http.StatusOK, b.Features())
+	}
+}
+
+// featureName returns the {name} segment of a features admin request. Gorilla
+// routes supply it as a path variable; under a plain ServeMux it is parsed
+// from the path, which must be featuresAdminPath or featuresAdminPath/{name}.
+func featureName(r *http.Request) (string, bool) {
+	if name, ok := mux.Vars(r)["name"]; ok {
+		return name, true
+	}
+	rest, found := strings.CutPrefix(r.URL.Path, featuresAdminPath)
+	if !found {
+		return "", false
+	}
+	if rest == "" || rest == "/" {
+		return "", true
+	}
+	name, found := strings.CutPrefix(rest, "/")
+	if !found || strings.Contains(name, "/") {
+		return "", false
+	}
+	return name, true
+}
+
+// envToken upper-cases a name and replaces anything outside [A-Z0-9] with '_'.
+func envToken(name string) string {
+	var sb strings.Builder
+	for _, r := range strings.ToUpper(name) {
+		if (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9') {
+			sb.WriteRune(r)
+		} else {
+			sb.WriteByte('_')
+		}
+	}
+	return sb.String()
+}
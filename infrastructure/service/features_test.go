@@ -0,0 +1,132 @@
+package service
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestFeatureEnabledResolutionOrder(t *testing.T) {
+	svc := NewBase(&BaseConfig{ID: "neofeeds"})
+	svc.WithFeature("chain-push", false)
+
+	if svc.FeatureEnabled("chain-push") {
+		t.Fatal("expected registered default (false)")
+	}
+
+	t.Setenv("FEATURE_CHAIN_PUSH", "true")
+	if !svc.FeatureEnabled("chain-push") {
+		t.Fatal("expected global env override to enable the flag")
+	}
+
+	t.Setenv("NEOFEEDS_FEATURE_CHAIN_PUSH", "false")
+	if svc.FeatureEnabled("chain-push") {
+		t.Fatal("expected service-scoped env to take precedence over global env")
+	}
+
+	svc.SetFeature("chain-push", true)
+	if !svc.FeatureEnabled("chain-push") {
+		t.Fatal("expected explicit override to take precedence over env")
+	}
+
+	svc.ClearFeature("chain-push")
+	if svc.FeatureEnabled("chain-push") {
+		t.Fatal("expected resolution to fall back to env after ClearFeature")
+	}
+}
+
+func TestFeaturesListsRegisteredAndOverridden(t *testing.T) {
+	svc := NewBase(&BaseConfig{ID: "svc"})
+	svc.WithFeature("a", true)
+	svc.SetFeature("b", false)
+
+	got := svc.Features()
+	if len(got) != 2 || !got["a"] || got["b"] {
+		t.Fatalf("Features() = %v", got)
+	}
+	if unknown := svc.FeatureEnabled("unknown"); unknown {
+		t.Fatal("unregistered flags should default to false")
+	}
+}
+
+func TestFeaturesAdminHandler(t *testing.T) {
+	svc := NewBase(&BaseConfig{ID: "svc"})
+	svc.WithFeature("chain-push", false)
+	svc.RegisterStandardRoutes()
+
+	call := func(method, path, body string, headers map[string]string) *httptest.ResponseRecorder {
+		t.Helper()
+		req := httptest.NewRequest(method, path, strings.NewReader(body))
+		for k, v := range headers {
+			req.Header.Set(k, v)
+		}
+		rr := httptest.NewRecorder()
+		svc.Router().ServeHTTP(rr, req)
+		return rr
+	}
+	service := map[string]string{"X-Service-ID": "gateway"}
+
+	if rr := call(http.MethodPut, "/admin/features/chain-push", `{"enabled":true}`, nil); rr.Code != http.StatusUnauthorized {
+		t.Fatalf("anonymous status = %d, want 401", rr.Code)
+	}
+	if rr := call(http.MethodPut, "/admin/features/chain-push", `{"enabled":true}`, map[string]string{"X-User-Role": "user"}); rr.Code != http.StatusForbidden {
+		t.Fatalf("non-admin status = %d, want 403", rr.Code)
+	}
+	if svc.FeatureEnabled("chain-push") {
+		t.Fatal("rejected calls must not change flags")
+	}
+
+	if rr := call(http.MethodPut, "/admin/features/chain-push", `{"enabled":true}`, service); rr.Code != http.StatusOK {
+		t.Fatalf("service PUT status = %d: %s", rr.Code, rr.Body.String())
+	}
+	if !svc.FeatureEnabled("chain-push") {
+		t.Fatal("PUT should set the override")
+	}
+	if rr := call(http.MethodPut, "/admin/features/chain-push", `{}`, service); rr.Code != http.StatusBadRequest {
+		t.Fatalf("PUT without enabled status = %d, want 400", rr.Code)
+	}
+
+	if rr := call(http.MethodDelete, "/admin/features/chain-push", "", map[string]string{"X-User-Role": "admin"}); rr.Code != http.StatusOK {
+		t.Fatalf("admin DELETE status = %d", rr.Code)
+	}
+	if svc.FeatureEnabled("chain-push") {
+		t.Fatal("DELETE should clear the override")
+	}
+
+	rr := call(http.MethodGet, "/admin/features", "", service)
+	if rr.Code != http.StatusOK || !strings.Contains(rr.Body.String(), `"chain-push":false`) {
+		t.Fatalf("GET = %d %s", rr.Code, rr.Body.String())
+	}
+
+	for _, path := range []string{"/admin/featuresfoo", "/admin/features/a/b"} {
+		if rr := call(http.MethodPut, path, `{"enabled":true}`, service); rr.Code != http.StatusNotFound {
+			t.Fatalf("PUT %s status = %d, want 404", path, rr.Code)
+		}
+	}
+	if got := svc.Features(); len(got) != 1 {
+		t.Fatalf("Features() = %v, want only chain-push", got)
+	}
+}
+
+func TestFeaturesAdminHandlerServeMux(t *testing.T) {
+	svc := NewBase(&BaseConfig{ID: "svc"})
+	handler := FeaturesAdminHandler(svc)
+
+	for path, want := range map[string]int{
+		"/admin/features/chain-push": http.StatusOK,
+		"/admin/featuresfoo":         http.StatusNotFound,
+		"/admin/features/a/b":        http.StatusNotFound,
+	} {
+		req := httptest.NewRequest(http.MethodPut, path, strings.NewReader(`{"enabled":true}`))
+		req.Header.Set("X-Service-ID", "gateway")
+		rr := httptest.NewRecorder()
+		handler(rr, req)
+		if rr.Code != want {
+			t.Fatalf("PUT %s status = %d, want %d", path, rr.Code, want)
+		}
+	}
+	if !svc.FeatureEnabled("chain-push") || svc.FeatureEnabled("foo") {
+		t.Fatalf("Features() = %v, want only chain-push set", svc.Features())
+	}
+}
@@ -0,0 +1,36 @@
+package service
+
+import (
+	"crypto/rsa"
+	"net/http"
+
+	"github.com/R3E-Network/neo-miniapps-platform/infrastructure/httputil"
+	"github.com/R3E-Network/neo-miniapps-platform/infrastructure/serviceauth"
+)
+
+// WithJWKS publishes the public keys of the RS256 service tokens this service
+// issues at serviceauth.JWKSPath, so verifiers do not need a shared secret.
+// Pass the current key and, during rotation, the previous one. It may be
+// called after the standard routes are registered.
+func (b *BaseService) WithJWKS(keys ...*rsa.PublicKey) *BaseService {
+	handler := serviceauth.JWKSHandler(keys...)
+	b.jwksMu.Lock()
+	b.jwks = handler
+	b.jwksMu.Unlock()
+	return b
+}
+
+// JWKSHandler serves the key set configured with WithJWKS, or 404 when the
+// service does not issue RS256 tokens.
+func JWKSHandler(b *BaseService) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		b.jwksMu.RLock()
+		handler := b.jwks
+		b.jwksMu.RUnlock()
+		if handler == nil {
+			httputil.NotFound(w, "no service token keys published")
+			return
+		}
+		handler(w, r)
+	}
+}
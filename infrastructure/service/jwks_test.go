@@ -0,0 +1,43 @@
+package service
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/R3E-Network/neo-miniapps-platform/infrastructure/serviceauth"
+)
+
+func TestStandardRoutesServeJWKS(t *testing.T) {
+	svc := NewBase(&BaseConfig{ID: "svc"})
+	svc.RegisterStandardRoutes()
+
+	rec := httptest.NewRecorder()
+	svc.Router().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, serviceauth.JWKSPath, nil))
+	if rec.Code != http.StatusNotFound {
+		t.Fatalf("status without keys = %d, want %d", rec.Code, http.StatusNotFound)
+	}
+
+	priv, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("GenerateKey: %v", err)
+	}
+	// Keys configured after route registration are still served.
+	svc.WithJWKS(&priv.PublicKey)
+
+	rec = httptest.NewRecorder()
+	svc.Router().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, serviceauth.JWKSPath, nil))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d", rec.Code, http.StatusOK)
+	}
+	var set serviceauth.JWKSet
+	if err := json.Unmarshal(rec.Body.Bytes(), &set); err != nil {
+		t.Fatalf("decode jwks: %v", err)
+	}
+	if len(set.Keys) != 1 || set.Keys[0].Kid != serviceauth.KeyID(&priv.PublicKey) {
+		t.Fatalf("keys = %+v", set.Keys)
+	}
+}
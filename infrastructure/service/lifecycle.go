@@ -0,0 +1,171 @@
+package service
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/R3E-Network/neo-miniapps-platform/infrastructure/httputil"
+)
+
+// =============================================================================
+// Lifecycle State
+// =============================================================================
+
+// LifecycleState describes where a BaseService is in its start/stop lifecycle.
+type LifecycleState string
+
+const (
+	StateCreated  LifecycleState = "created"
+	StateStarting LifecycleState = "starting"
+	StateRunning  LifecycleState = "running"
+	// StateDegraded means the service is serving requests but hydrate has not
+	// succeeded yet; see WithDegradedMode.
+	StateDegraded LifecycleState = "degraded"
+	StateStopping LifecycleState = "stopping"
+	StateStopped  LifecycleState = "stopped"
+	StateFailed   LifecycleState = "failed"
+	// StateStopFailed means Stop returned an error or did not finish within
+	// its shutdown budget, so resources may not have been released.
+	StateStopFailed LifecycleState = "stop_failed"
+)
+
+// ServiceDescription is a machine-readable snapshot of a service's identity,
+// lifecycle and registered hooks. It is served to operators from the
+// /describe endpoint.
+type ServiceDescription struct {
+	ID              string            `json:"id"`
+	Name            string            `json:"name"`
+	Version         string            `json:"version"`
+	Enclave         bool              `json:"enclave"`
+	State           LifecycleState    `json:"state"`
+	StateChangedAt  string            `json:"state_changed_at,omitempty"`
+	Stalled         bool              `json:"stalled,omitempty"`
+	StartedAt       string            `json:"started_at,omitempty"`
+	Uptime          string            `json:"uptime"`
+	LastError       string            `json:"last_error,omitempty"`
+	Restarts        int               `json:"restarts"`
+	Health          string            `json:"health"`
+	Ready           bool              `json:"ready"`
+	Dependencies    map[string]string `json:"dependencies,omitempty"`
+	Workers         int               `json:"workers"`
+	HasHydrate      bool              `json:"has_hydrate"`
+	RequiredSecrets []string          `json:"required_secrets,omitempty"`
+	Features        map[string]bool   `json:"features,omitempty"`
+}
+
+// State returns the current lifecycle state.
+func (b *BaseService) State() LifecycleState {
+	b.stateMu.RLock()
+	defer b.stateMu.RUnlock()
+	if b.state == "" {
+		return StateCreated
+	}
+	return b.state
+}
+
+func (b *BaseService) setState(state LifecycleState, err error) {
+	b.stateMu.Lock()
+	// Any Start after the first one, including after a failure, is a restart.
+	if state == StateStarting && b.state != "" && b.state != StateCreated {
+		b.restarts++
+	}
+	b.state = state
+	b.stateChangedAt = time.Now()
+	switch {
+	case err != nil:
+		b.lastErr = err.Error()
+	case state == StateRunning:
+		// A service that reached running has recovered from the last error.
+		b.lastErr = ""
+	}
+	b.stateMu.Unlock()
+}
+
+// MarkStopFailed records that stopping the service failed or timed out, so it
+// is not left reported as stopping.
+func (b *BaseService) MarkStopFailed(err error) {
+	b.setState(StateStopFailed, err)
+}
+
+// ready reports whether a service with the given health status should
+// receive traffic. Degraded-mode services keep receiving traffic for the
+// paths that do not need the missing dependency.
+func (b *BaseService) ready(status string) bool {
+	return status == "healthy" || b.IsDegraded()
+}
+
+// dependenciesLocked reports the cached state of each dependency the service
+// was configured with. Callers must hold healthMu.
+func (b *BaseService) dependenciesLocked() map[string]string {
+	deps := map[string]string{}
+	if b.DB() != nil {
+		deps["database"] = "healthy"
+		if !b.dbHealthy {
+			deps["database"] = "unhealthy"
+		}
+	}
+	if len(b.requiredSecrets) > 0 {
+		deps["secrets"] = "loaded"
+		if !b.secretsLoaded {
+			deps["secrets"] = "missing"
+		}
+	}
+	if len(deps) == 0 {
+		return nil
+	}
+	return deps
+}
+
+// Describe returns a machine-readable description of the service.
+// It reports the cached health state and does not probe dependencies.
+func (b *BaseService) Describe() ServiceDescription {
+	desc := ServiceDescription{
+		ID:              b.ID(),
+		Name:            b.Name(),
+		Version:         b.Version(),
+		Enclave:         b.Marble() != nil && b.Marble().IsEnclave(),
+		Workers:         b.WorkerCount(),
+		HasHydrate:      b.hydrate != nil,
+		RequiredSecrets: append([]string(nil), b.requiredSecrets...),
+		Features:        b.Features(),
+	}
+
+	b.stateMu.RLock()
+	desc.State = b.state
+	if desc.State == "" {
+		desc.State = StateCreated
+	}
+	if !b.stateChangedAt.IsZero() {
+		desc.StateChangedAt = b.stateChangedAt.Format(time.RFC3339)
+	}
+	desc.LastError = b.lastErr
+	desc.Restarts = b.restarts
+	b.stateMu.RUnlock()
+	_, _, desc.Stalled = b.Stalled()
+
+	b.healthMu.RLock()
+	desc.Health = b.healthStatusLocked()
+	desc.Dependencies = b.dependenciesLocked()
+	uptime := time.Duration(0)
+	if !b.startTime.IsZero() {
+		desc.StartedAt = b.startTime.Format(time.RFC3339)
+		uptime = time.Since(b.startTime)
+	}
+	b.healthMu.RUnlock()
+	desc.Uptime = uptime.String()
+	desc.Ready = (desc.State == StateRunning || desc.State == StateDegraded) && b.ready(desc.Health)
+
+	return desc
+}
+
+// DescribeHandler returns a /describe handler for BaseService. The
+// description names required secrets and internal state, so it is only
+// served to other services and admins.
+func DescribeHandler(s *BaseService) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if !requireOperator(w, r) {
+			return
+		}
+		httputil.WriteJSON(w, http.StatusOK, s.Describe())
+	}
+}
@@ -0,0 +1,110 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestDescribeTracksLifecycle(t *testing.T) {
+	svc := NewBase(&BaseConfig{ID: "svc", Name: "Service", Version: "1.0.0"})
+	svc.AddWorker(func(ctx context.Context) { <-svc.StopChan() })
+
+	if got := svc.Describe().State; got != StateCreated {
+		t.Fatalf("initial state = %q, want %q", got, StateCreated)
+	}
+
+	if err := svc.Start(context.Background()); err != nil {
+		t.Fatalf("Start() error = %v", err)
+	}
+	desc := svc.Describe()
+	if desc.State != StateRunning {
+		t.Fatalf("state after Start = %q, want %q", desc.State, StateRunning)
+	}
+	if desc.Workers != 1 || desc.StartedAt == "" || desc.Health != "healthy" {
+		t.Fatalf("unexpected description: %+v", desc)
+	}
+
+	if err := svc.Stop(); err != nil {
+		t.Fatalf("Stop() error = %v", err)
+	}
+	if got := svc.State(); got != StateStopped {
+		t.Fatalf("state after Stop = %q, want %q", got, StateStopped)
+	}
+}
+
+func TestDescribeReportsHydrateFailure(t *testing.T) {
+	svc := NewBase(&BaseConfig{ID: "svc"})
+	svc.WithHydrate(func(context.Context) error { return errors.New("boom") })
+
+	if err := svc.Start(context.Background()); err == nil {
+		t.Fatal("Start() expected hydrate error")
+	}
+	desc := svc.Describe()
+	if desc.State != StateFailed {
+		t.Fatalf("state = %q, want %q", desc.State, StateFailed)
+	}
+	if desc.LastError != "hydrate: boom" || !desc.HasHydrate {
+		t.Fatalf("unexpected description: %+v", desc)
+	}
+}
+
+func TestDescribeHandler(t *testing.T) {
+	svc := NewBase(&BaseConfig{ID: "svc", Name: "Service"})
+	svc.RegisterStandardRoutes()
+
+	rec := httptest.NewRecorder()
+	svc.Router().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/describe", nil))
+	if rec.Code != http.StatusUnauthorized {
+		t.Fatalf("anonymous status = %d, want %d", rec.Code, http.StatusUnauthorized)
+	}
+
+	rec = httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, "/describe", nil)
+	req.Header.Set("X-Service-ID", "gateway")
+	svc.Router().ServeHTTP(rec, req)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d", rec.Code, http.StatusOK)
+	}
+
+	var desc ServiceDescription
+	if err := json.Unmarshal(rec.Body.Bytes(), &desc); err != nil {
+		t.Fatalf("decode response: %v", err)
+	}
+	if desc.ID != "svc" || desc.State != StateCreated {
+		t.Fatalf("unexpected description: %+v", desc)
+	}
+}
+
+func TestDescribeRestartClearsLastError(t *testing.T) {
+	svc := NewBase(&BaseConfig{ID: "svc"})
+	fail := true
+	svc.WithHydrate(func(context.Context) error {
+		if fail {
+			return errors.New("boom")
+		}
+		return nil
+	})
+
+	if err := svc.Start(context.Background()); err == nil {
+		t.Fatal("Start() expected hydrate error")
+	}
+	if desc := svc.Describe(); desc.Ready || desc.Restarts != 0 {
+		t.Fatalf("after failed start: %+v", desc)
+	}
+
+	fail = false
+	if err := svc.Start(context.Background()); err != nil {
+		t.Fatalf("second Start() error = %v", err)
+	}
+	desc := svc.Describe()
+	if desc.State != StateRunning || desc.LastError != "" {
+		t.Fatalf("state = %q, last_error = %q; want running with no error", desc.State, desc.LastError)
+	}
+	if desc.Restarts != 1 || !desc.Ready {
+		t.Fatalf("restarts = %d, ready = %v; want 1, true", desc.Restarts, desc.Ready)
+	}
+}
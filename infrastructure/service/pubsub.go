@@ -0,0 +1,136 @@
+package service
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// =============================================================================
+// In-Process Pub/Sub
+// =============================================================================
+
+// defaultSubscriberBuffer is used when Subscribe is called with buffer <= 0.
+const defaultSubscriberBuffer = 16
+
+// Message is a payload published on a topic.
+type Message struct {
+	Topic       string
+	Payload     any
+	PublishedAt time.Time
+}
+
+// PubSub is a lightweight in-process topic broker for decoupling components
+// within a single marble (e.g. event handlers and background workers).
+// Delivery is best-effort: a subscriber whose buffer is full misses the
+// message rather than blocking the publisher.
+type PubSub struct {
+	mu      sync.RWMutex
+	topics  map[string]map[uint64]*subscription
+	nextID  uint64
+	closed  bool
+	dropped atomic.Uint64
+}
+
+type subscription struct {
+	ch   chan Message
+	once sync.Once
+}
+
+// NewPubSub creates an empty broker.
+func NewPubSub() *PubSub {
+	return &PubSub{topics: make(map[string]map[uint64]*subscription)}
+}
+
+// Topics returns the service's in-process broker.
+// All subscriptions are closed when the service stops.
+func (b *BaseService) Topics() *PubSub {
+	return b.topics
+}
+
+// Subscribe registers for messages on topic. The returned cancel function
+// unsubscribes and closes the channel; it is safe to call more than once.
+func (p *PubSub) Subscribe(topic string, buffer int) (<-chan Message, func()) {
+	if buffer <= 0 {
+		buffer = defaultSubscriberBuffer
+	}
+	sub := &subscription{ch: make(chan Message, buffer)}
+
+	p.mu.Lock()
+	if p.closed {
+		p.mu.Unlock()
+		close(sub.ch)
+		return sub.ch, func() {}
+	}
+	p.nextID++
+	id := p.nextID
+	if p.topics[topic] == nil {
+		p.topics[topic] = make(map[uint64]*subscription)
+	}
+	p.topics[topic][id] = sub
+	p.mu.Unlock()
+
+	cancel := func() {
+		p.mu.Lock()
+		if subs := p.topics[topic]; subs != nil {
+			delete(subs, id)
+			if len(subs) == 0 {
+				delete(p.topics, topic)
+			}
+		}
+		p.mu.Unlock()
+		sub.once.Do(func() { close(sub.ch) })
+	}
+	return sub.ch, cancel
+}
+
+// Publish delivers payload to every current subscriber of topic and returns
+// the number of subscribers that received it.
+func (p *PubSub) Publish(topic string, payload any) int {
+	msg := Message{Topic: topic, Payload: payload, PublishedAt: time.Now()}
+
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	if p.closed {
+		return 0
+	}
+
+	delivered := 0
+	for _, sub := range p.topics[topic] {
+		select {
+		case sub.ch <- msg:
+			delivered++
+		default:
+			p.dropped.Add(1)
+		}
+	}
+	return delivered
+}
+
+// SubscriberCount returns the number of subscribers on topic.
+func (p *PubSub) SubscriberCount(topic string) int {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	return len(p.topics[topic])
+}
+
+// Dropped returns how many deliveries were skipped because a subscriber was full.
+func (p *PubSub) Dropped() uint64 {
+	return p.dropped.Load()
+}
+
+// Close closes every subscription. Later publishes are ignored.
+func (p *PubSub) Close() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.closed {
+		return
+	}
+	p.closed = true
+	for topic, subs := range p.topics {
+		for _, sub := range subs {
+			sub.once.Do(func() { close(sub.ch) })
+		}
+		delete(p.topics, topic)
+	}
+}
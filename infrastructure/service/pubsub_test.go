@@ -0,0 +1,75 @@
+package service
+
+import (
+	"context"
+	"testing"
+)
+
+func TestPubSubPublishSubscribe(t *testing.T) {
+	ps := NewPubSub()
+	ch, cancel := ps.Subscribe("prices", 1)
+	defer cancel()
+
+	if n := ps.Publish("prices", 42); n != 1 {
+		t.Fatalf("Publish() delivered to %d, want 1", n)
+	}
+	msg := <-ch
+	if msg.Topic != "prices" || msg.Payload != 42 || msg.PublishedAt.IsZero() {
+		t.Fatalf("unexpected message: %+v", msg)
+	}
+
+	if n := ps.Publish("other", 1); n != 0 {
+		t.Fatalf("Publish() on unrelated topic delivered to %d, want 0", n)
+	}
+}
+
+func TestPubSubDropsWhenSubscriberFull(t *testing.T) {
+	ps := NewPubSub()
+	_, cancel := ps.Subscribe("t", 1)
+	defer cancel()
+
+	ps.Publish("t", 1)
+	if n := ps.Publish("t", 2); n != 0 {
+		t.Fatalf("Publish() to full subscriber delivered to %d, want 0", n)
+	}
+	if got := ps.Dropped(); got != 1 {
+		t.Fatalf("Dropped() = %d, want 1", got)
+	}
+}
+
+func TestPubSubCancelAndClose(t *testing.T) {
+	ps := NewPubSub()
+	ch, cancel := ps.Subscribe("t", 0)
+	cancel()
+	cancel()
+	if _, ok := <-ch; ok {
+		t.Fatal("channel should be closed after cancel")
+	}
+	if ps.SubscriberCount("t") != 0 {
+		t.Fatal("subscriber should be removed after cancel")
+	}
+
+	ch2, _ := ps.Subscribe("t", 0)
+	ps.Close()
+	if _, ok := <-ch2; ok {
+		t.Fatal("channel should be closed after Close")
+	}
+	if n := ps.Publish("t", 1); n != 0 {
+		t.Fatalf("Publish() after Close delivered to %d, want 0", n)
+	}
+}
+
+func TestBaseServiceTopicsClosedOnStop(t *testing.T) {
+	svc := NewBase(&BaseConfig{ID: "svc"})
+	ch, _ := svc.Topics().Subscribe("t", 0)
+
+	if err := svc.Start(context.Background()); err != nil {
+		t.Fatalf("Start() error = %v", err)
+	}
+	if err := svc.Stop(); err != nil {
+		t.Fatalf("Stop() error = %v", err)
+	}
+	if _, ok := <-ch; ok {
+		t.Fatal("subscriptions should be closed on Stop")
+	}
+}
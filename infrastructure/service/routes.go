@@ -3,11 +3,14 @@ package service
 
 import (
 	"net/http"
+	"strings"
 	"time"
 
 	"github.com/gorilla/mux"
 
 	"github.com/R3E-Network/neo-miniapps-platform/infrastructure/httputil"
+	"github.com/R3E-Network/neo-miniapps-platform/infrastructure/runtime"
+	"github.com/R3E-Network/neo-miniapps-platform/infrastructure/serviceauth"
 )
 
 // =============================================================================
@@ -44,6 +47,9 @@ func HealthHandler(s *BaseService) http.HandlerFunc {
 		status := "healthy"
 		var details map[string]any
 
+		if wantsFreshHealth(r) {
+			s.CheckHealth()
+		}
 		// Check if service implements HealthChecker for custom status
 		if checker, ok := interface{}(s).(HealthChecker); ok {
 			status = checker.HealthStatus()
@@ -70,6 +76,9 @@ func ReadinessHandler(s *BaseService) http.HandlerFunc {
 		status := "healthy"
 		var details map[string]any
 
+		if wantsFreshHealth(r) {
+			s.CheckHealth()
+		}
 		if checker, ok := interface{}(s).(HealthChecker); ok {
 			status = checker.HealthStatus()
 			if status != "healthy" {
@@ -87,7 +96,7 @@ func ReadinessHandler(s *BaseService) http.HandlerFunc {
 		}
 
 		code := http.StatusOK
-		if status != "healthy" {
+		if !s.ready(status) {
 			code = http.StatusServiceUnavailable
 		}
 
@@ -95,6 +104,36 @@ func ReadinessHandler(s *BaseService) http.HandlerFunc {
 	}
 }
 
+// requireOperator admits authenticated services and admin users. Otherwise it
+// writes 401 (no identity) or 403 (a non-admin user) and returns false.
+func requireOperator(w http.ResponseWriter, r *http.Request) bool {
+	if httputil.GetServiceID(r) != "" {
+		return true
+	}
+	if httputil.GetUserRole(r) == "" {
+		httputil.Unauthorized(w, "service or admin authentication required")
+		return false
+	}
+	return httputil.RequireAdminRole(w, r)
+}
+
+// isOperator reports whether the request comes from another service or an
+// admin user, without writing a response.
+func isOperator(r *http.Request) bool {
+	if httputil.GetServiceID(r) != "" {
+		return true
+	}
+	role := strings.ToLower(httputil.GetUserRole(r))
+	return role == "admin" || role == "super_admin"
+}
+
+// wantsFreshHealth reports whether the caller asked to bypass the health cache
+// with ?fresh=true. Only operators may force a probe; for anyone else the
+// parameter is ignored so unauthenticated polling cannot hammer dependencies.
+func wantsFreshHealth(r *http.Request) bool {
+	return runtime.ParseBoolValue(r.URL.Query().Get("fresh")) && isOperator(r)
+}
+
 // InfoHandler returns a standardized /info handler for BaseService.
 // It includes statistics from the registered stats function if available.
 func InfoHandler(s *BaseService) http.HandlerFunc {
@@ -185,7 +224,9 @@ type RouteOptions struct {
 	SkipInfo bool // Skip /info registration (for services with custom /info)
 }
 
-// RegisterStandardRoutes registers the standard /health, /ready, and /info endpoints.
+// RegisterStandardRoutes registers the standard /health, /ready, /info and
+// /.well-known/jwks.json endpoints, plus the operator-only /describe and
+// /admin/features endpoints.
 // This should be called by services that want consistent endpoint behavior.
 func (b *BaseService) RegisterStandardRoutes() {
 	b.RegisterStandardRoutesWithOptions(RouteOptions{})
@@ -197,6 +238,10 @@ func (b *BaseService) RegisterStandardRoutesWithOptions(opts RouteOptions) {
 	router := b.Router()
 	router.HandleFunc("/health", HealthHandler(b)).Methods("GET")
 	router.HandleFunc("/ready", ReadinessHandler(b)).Methods("GET")
+	router.HandleFunc("/describe", DescribeHandler(b)).Methods("GET")
+	router.HandleFunc(serviceauth.JWKSPath, JWKSHandler(b)).Methods("GET")
+	router.HandleFunc(featuresAdminPath, FeaturesAdminHandler(b))
+	router.HandleFunc(featuresAdminPath+"/{name}", FeaturesAdminHandler(b))
 	if !opts.SkipInfo {
 		router.HandleFunc("/info", InfoHandler(b)).Methods("GET")
 	}
@@ -4,6 +4,7 @@ import (
 	"net/http"
 
 	"github.com/R3E-Network/neo-miniapps-platform/infrastructure/httputil"
+	"github.com/R3E-Network/neo-miniapps-platform/infrastructure/serviceauth"
 )
 
 func onlyGetOrHead(next http.HandlerFunc) http.HandlerFunc {
@@ -16,7 +17,8 @@ func onlyGetOrHead(next http.HandlerFunc) http.HandlerFunc {
 	}
 }
 
-// RegisterStandardRoutesOnServeMux registers /health, /ready, and /info on an http.ServeMux.
+// RegisterStandardRoutesOnServeMux registers /health, /ready, /info, /describe,
+// /.well-known/jwks.json and /admin/features on an http.ServeMux.
 // This is useful for services that are composed into an existing net/http server.
 func (b *BaseService) RegisterStandardRoutesOnServeMux(mux *http.ServeMux) {
 	b.RegisterStandardRoutesOnServeMuxWithOptions(mux, RouteOptions{})
@@ -31,6 +33,10 @@ func (b *BaseService) RegisterStandardRoutesOnServeMuxWithOptions(mux *http.Serv
 
 	mux.HandleFunc("/health", onlyGetOrHead(HealthHandler(b)))
 	mux.HandleFunc("/ready", onlyGetOrHead(ReadinessHandler(b)))
+	mux.HandleFunc("/describe", onlyGetOrHead(DescribeHandler(b)))
+	mux.HandleFunc(serviceauth.JWKSPath, onlyGetOrHead(JWKSHandler(b)))
+	mux.HandleFunc(featuresAdminPath, FeaturesAdminHandler(b))
+	mux.HandleFunc(featuresAdminPath+"/", FeaturesAdminHandler(b))
 	if !opts.SkipInfo {
 		mux.HandleFunc("/info", onlyGetOrHead(InfoHandler(b)))
 	}
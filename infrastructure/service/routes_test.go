@@ -1,11 +1,16 @@
 package service
 
 import (
+	"errors"
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/gorilla/mux"
+
+	"github.com/R3E-Network/neo-miniapps-platform/infrastructure/database"
+	"github.com/R3E-Network/neo-miniapps-platform/infrastructure/marble"
 )
 
 func TestRouteGroupMethodsRestrictsMethods(t *testing.T) {
@@ -33,3 +38,75 @@ func TestRouteGroupMethodsRestrictsMethods(t *testing.T) {
 		t.Fatalf("POST expected status %d, got %d", http.StatusMethodNotAllowed, rec.Code)
 	}
 }
+
+func TestReadinessUsesHealthCache(t *testing.T) {
+	t.Setenv("SUPABASE_URL", "http://db")
+	t.Setenv("SUPABASE_SERVICE_KEY", "key")
+
+	m, err := marble.New(marble.Config{MarbleType: "svc"})
+	if err != nil {
+		t.Fatalf("marble.New() error = %v", err)
+	}
+	db := database.NewMockRepository()
+	svc := NewBase(&BaseConfig{ID: "svc", Marble: m, DB: db, HealthCacheTTL: time.Minute})
+	handler := ReadinessHandler(svc)
+
+	rec := httptest.NewRecorder()
+	handler(rec, httptest.NewRequest(http.MethodGet, "/ready", nil))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("first probe status = %d, want %d", rec.Code, http.StatusOK)
+	}
+
+	// The DB goes down, but the cached result is reused within the TTL.
+	db.ErrorOnNextCall = errors.New("connection refused")
+	rec = httptest.NewRecorder()
+	handler(rec, httptest.NewRequest(http.MethodGet, "/ready", nil))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("cached probe status = %d, want %d", rec.Code, http.StatusOK)
+	}
+
+	// ?fresh=true from an anonymous caller is ignored.
+	rec = httptest.NewRecorder()
+	handler(rec, httptest.NewRequest(http.MethodGet, "/ready?fresh=true", nil))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("anonymous fresh probe status = %d, want %d", rec.Code, http.StatusOK)
+	}
+
+	// ?fresh=true from a service bypasses the cache.
+	rec = httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, "/ready?fresh=true", nil)
+	req.Header.Set("X-Service-ID", "gateway")
+	handler(rec, req)
+	if rec.Code != http.StatusServiceUnavailable {
+		t.Fatalf("fresh probe status = %d, want %d", rec.Code, http.StatusServiceUnavailable)
+	}
+}
+
+func TestHealthCacheDisabled(t *testing.T) {
+	t.Setenv("SUPABASE_URL", "http://db")
+	t.Setenv("SUPABASE_SERVICE_KEY", "key")
+
+	db := database.NewMockRepository()
+	svc := NewBase(&BaseConfig{ID: "svc", DB: db, HealthCacheTTL: -1})
+
+	if got := svc.HealthStatus(); got != "healthy" {
+		t.Fatalf("HealthStatus() = %q, want healthy", got)
+	}
+	db.ErrorOnNextCall = errors.New("connection refused")
+	if got := svc.HealthStatus(); got != "unhealthy" {
+		t.Fatalf("HealthStatus() without cache = %q, want unhealthy", got)
+	}
+}
+
+func TestResolveHealthCacheTTL(t *testing.T) {
+	if got := resolveHealthCacheTTL(0); got != DefaultHealthCacheTTL {
+		t.Fatalf("default TTL = %s, want %s", got, DefaultHealthCacheTTL)
+	}
+	t.Setenv("HEALTH_CACHE_TTL", "0")
+	if got := resolveHealthCacheTTL(0); got != 0 {
+		t.Fatalf("HEALTH_CACHE_TTL=0 gave %s, want 0", got)
+	}
+	if got := resolveHealthCacheTTL(5 * time.Second); got != 5*time.Second {
+		t.Fatalf("explicit TTL = %s, want 5s", got)
+	}
+}
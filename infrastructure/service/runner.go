@@ -2,6 +2,7 @@ package service
 
 import (
 	"context"
+	"crypto/rsa"
 	"encoding/hex"
 	"fmt"
 	"log"
@@ -25,9 +26,11 @@ import (
 	"github.com/R3E-Network/neo-miniapps-platform/infrastructure/marble"
 	slmetrics "github.com/R3E-Network/neo-miniapps-platform/infrastructure/metrics"
 	slmiddleware "github.com/R3E-Network/neo-miniapps-platform/infrastructure/middleware"
+	"github.com/R3E-Network/neo-miniapps-platform/infrastructure/resilience"
 	"github.com/R3E-Network/neo-miniapps-platform/infrastructure/runtime"
 	"github.com/R3E-Network/neo-miniapps-platform/infrastructure/secrets"
 	secretssupabase "github.com/R3E-Network/neo-miniapps-platform/infrastructure/secrets/supabase"
+	"github.com/R3E-Network/neo-miniapps-platform/infrastructure/serviceauth"
 	txproxyclient "github.com/R3E-Network/neo-miniapps-platform/infrastructure/txproxy/client"
 	txproxytypes "github.com/R3E-Network/neo-miniapps-platform/infrastructure/txproxy/types"
 )
@@ -55,6 +58,7 @@ type RunOption func(*runConfig)
 
 type runConfig struct {
 	eventStartBlockFn EventStartBlockFn
+	shutdownTimeout   time.Duration
 }
 
 // WithEventStartBlock sets a callback to resolve the event listener start block.
@@ -62,6 +66,12 @@ func WithEventStartBlock(fn EventStartBlockFn) RunOption {
 	return func(cfg *runConfig) { cfg.eventStartBlockFn = fn }
 }
 
+// WithShutdownTimeout bounds each graceful shutdown step (HTTP server, event
+// listener, service). Defaults to SERVICE_SHUTDOWN_TIMEOUT or DefaultShutdownTimeout.
+func WithShutdownTimeout(d time.Duration) RunOption {
+	return func(cfg *runConfig) { cfg.shutdownTimeout = d }
+}
+
 // Run is the unified marble entry point. It initializes all shared
 // infrastructure (marble, DB, chain, TEE signer, event listener, txproxy,
 // gasbank), selects the service factory by MARBLE_TYPE, applies standard
@@ -115,8 +125,14 @@ func Run(factories map[string]Factory, opts ...RunOption) {
 	}
 
 	// --- Database ---
-	supabaseURL := config.EnvOrSecret(m, "SUPABASE_URL", "")
-	supabaseServiceKey := config.EnvOrSecret(m, "SUPABASE_SERVICE_KEY", "")
+	supabaseURL, err := m.RequireSecretOrEnv("SUPABASE_URL", "SUPABASE_URL")
+	if err != nil {
+		log.Fatalf("CRITICAL: %v", err)
+	}
+	supabaseServiceKey, err := m.RequireSecretOrEnv("SUPABASE_SERVICE_KEY", "SUPABASE_SERVICE_KEY")
+	if err != nil {
+		log.Fatalf("CRITICAL: %v", err)
+	}
 	dbClient, err := database.NewClient(database.Config{
 		URL:        supabaseURL,
 		ServiceKey: supabaseServiceKey,
@@ -125,9 +141,13 @@ func Run(factories map[string]Factory, opts ...RunOption) {
 		log.Fatalf("Failed to create database client: %v", err)
 	}
 	db := database.NewRepository(dbClient)
+	stopSecretsSweep := startSecretsExpirySweep(ctx, m, db, serviceType)
 
 	// --- Chain ---
 	chainClient, chainID, chainMeta := initChain(m)
+	if pool := chainClient.Pool(); pool != nil {
+		pool.Start(ctx)
+	}
 	contracts := resolveContracts(chainMeta)
 
 	paymentHubAddress := resolveAddress(contracts.PaymentHub, m, "CONTRACT_PAYMENT_HUB_ADDRESS")
@@ -187,11 +207,56 @@ func Run(factories map[string]Factory, opts ...RunOption) {
 		log.Fatalf("Failed to create service: %v", err)
 	}
 
+	// --- Degraded mode ---
+	if servicesCfg.IsNonCritical(serviceType, "database") {
+		if d, ok := svc.(interface {
+			WithDegradedMode(time.Duration) *BaseService
+		}); ok {
+			retry := runtime.ResolveDuration(0, "DEGRADED_RETRY_INTERVAL", DefaultDegradedRetryInterval)
+			d.WithDegradedMode(retry)
+			log.Printf("Degraded mode enabled: database is non-critical (retry every %s)", retry)
+		}
+	}
+
 	// --- Middleware ---
 	applyMiddleware(svc, serviceType, deps.Logger)
 
+	// --- Service token keys ---
+	// A service holding an RS256 service-token signing key publishes the
+	// public half at /.well-known/jwks.json for verifiers.
+	jwksKeys, err := serviceTokenPublicKeys(m)
+	if err != nil {
+		log.Fatalf("Invalid service token key: %v", err)
+	}
+	if len(jwksKeys) > 0 {
+		if j, ok := svc.(interface {
+			WithJWKS(...*rsa.PublicKey) *BaseService
+		}); ok {
+			j.WithJWKS(jwksKeys...)
+			log.Printf("Publishing %d service token key(s) at %s", len(jwksKeys), serviceauth.JWKSPath)
+		}
+	}
+
+	// --- Stall watchdog ---
+	// Stalls are reported by default; SERVICE_STALL_POLICY=fail also aborts.
+	stallPolicy, err := ParseStallPolicy(os.Getenv("SERVICE_STALL_POLICY"))
+	if err != nil {
+		log.Fatalf("Invalid SERVICE_STALL_POLICY: %v", err)
+	}
+	watchdog := StallWatchdog{
+		Timeout: runtime.ResolveDuration(0, "SERVICE_STALL_TIMEOUT", DefaultStallTimeout),
+		Policy:  stallPolicy,
+	}
+	if w, ok := svc.(interface {
+		WithStallWatchdog(time.Duration, StallPolicy) *BaseService
+	}); ok {
+		watchdog = w.WithStallWatchdog(watchdog.Timeout, watchdog.Policy).StallWatchdog()
+	}
+
 	// --- Start ---
-	if err := svc.Start(ctx); err != nil {
+	if err := RunWithStallWatchdog(serviceType, "start", watchdog, func() error {
+		return svc.Start(ctx)
+	}); err != nil {
 		log.Fatalf("Failed to start service: %v", err)
 	}
 
@@ -227,34 +292,70 @@ func Run(factories map[string]Factory, opts ...RunOption) {
 	<-sigCh
 
 	log.Println("Shutting down...")
-	shutdownCtx, shutdownCancel := context.WithTimeout(ctx, 30*time.Second)
-	defer shutdownCancel()
-
-	if err := server.Shutdown(shutdownCtx); err != nil {
+	shutdownTimeout := runtime.ResolveDuration(rc.shutdownTimeout, "SERVICE_SHUTDOWN_TIMEOUT", DefaultShutdownTimeout)
+	// The runner abandons the service step after shutdownTimeout, so a longer
+	// stop watchdog would never fire; cap it at the step budget.
+	stopWatchdog := watchdog
+	if stopWatchdog.Timeout > shutdownTimeout {
+		stopWatchdog.Timeout = shutdownTimeout
+	}
+
+	// Stop in reverse start order: stop accepting requests first, then stop
+	// feeding chain events, then stop the service and its workers.
+	err = gracefulShutdown(ctx, shutdownTimeout,
+		shutdownStep{name: "http server", fn: server.Shutdown},
+		shutdownStep{name: "event listener", fn: func(context.Context) error {
+			if eventListener != nil {
+				eventListener.Stop()
+			}
+			return nil
+		}},
+		shutdownStep{
+			name: "service",
+			fn: func(context.Context) error {
+				return RunWithStallWatchdog(serviceType, "stop", stopWatchdog, svc.Stop)
+			},
+			onError: func(err error) {
+				if f, ok := svc.(interface{ MarkStopFailed(error) }); ok {
+					f.MarkStopFailed(err)
+				}
+			},
+		},
+		shutdownStep{name: "secrets expiry sweep", fn: func(context.Context) error {
+			stopSecretsSweep()
+			return nil
+		}},
+		shutdownStep{name: "rpc pool", fn: func(context.Context) error {
+			if pool := chainClient.Pool(); pool != nil {
+				pool.Stop()
+			}
+			return nil
+		}},
+	)
+	if err != nil {
 		log.Printf("Shutdown error: %v", err)
 	}
-	if err := svc.Stop(); err != nil {
-		log.Printf("Service stop error: %v", err)
-	}
 	log.Println("Service stopped")
 }
 
 // NewServiceSecretsProvider creates a secrets.Provider for a given service.
 // Exported so service factories can use it.
 func NewServiceSecretsProvider(m *marble.Marble, db *database.Repository, serviceID string) secrets.Provider {
-	if db == nil {
+	manager := newSecretsManager(m, db, serviceID)
+	if manager == nil {
 		return nil
 	}
+	return secrets.ServiceProvider{Manager: manager, ServiceID: serviceID}
+}
 
-	var rawKey []byte
-	if m != nil {
-		if secret, ok := m.Secret(secrets.MasterKeyEnv); ok && len(secret) > 0 {
-			rawKey = secret
-		}
-	}
-	if len(rawKey) == 0 {
-		rawKey = []byte(strings.TrimSpace(os.Getenv(secrets.MasterKeyEnv)))
+// newSecretsManager builds the secrets manager for serviceID, or returns nil
+// when there is no database or (outside strict mode) no master key.
+func newSecretsManager(m *marble.Marble, db *database.Repository, serviceID string) *secrets.Manager {
+	if db == nil {
+		return nil
 	}
+
+	rawKey := m.SecretBytesOrEnv(secrets.MasterKeyEnv, secrets.MasterKeyEnv)
 	if len(rawKey) == 0 {
 		strict := runtime.StrictIdentityMode() || (m != nil && m.IsEnclave())
 		if strict {
@@ -268,7 +369,34 @@ func NewServiceSecretsProvider(m *marble.Marble, db *database.Repository, servic
 	if err != nil {
 		log.Fatalf("CRITICAL: initialize secrets manager for %s: %v", serviceID, err)
 	}
-	return secrets.ServiceProvider{Manager: manager, ServiceID: serviceID}
+	manager.SetLogger(sllogging.NewFromEnv(serviceID))
+	return manager
+}
+
+// startSecretsExpirySweep runs the secrets expiry sweep until the returned
+// stop function is called. Expired secrets never resolve; the sweep only
+// reclaims storage, so it is opt-in (SECRETS_EXPIRY_SWEEP_INTERVAL) and
+// normally enabled on a single marble.
+func startSecretsExpirySweep(ctx context.Context, m *marble.Marble, db *database.Repository, serviceID string) (stop func()) {
+	interval, ok := runtime.ParseEnvDuration("SECRETS_EXPIRY_SWEEP_INTERVAL")
+	if !ok || interval <= 0 {
+		return func() {}
+	}
+	manager := newSecretsManager(m, db, serviceID)
+	if manager == nil {
+		return func() {}
+	}
+
+	sweepCtx, cancel := context.WithCancel(ctx)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		manager.RunExpirySweeper(sweepCtx, interval)
+	}()
+	return func() {
+		cancel()
+		<-done
+	}
 }
 
 // =============================================================================
@@ -281,7 +409,7 @@ func initChain(m *marble.Marble) (*chain.Client, string, *chain.ChainConfig) {
 		neoRPCURLs = chain.ParseEndpoints(os.Getenv("NEO_RPC_URLS"))
 	}
 
-	neoRPCURL := config.EnvOrSecret(m, "NEO_RPC_URL", "")
+	neoRPCURL := m.SecretOrEnv("NEO_RPC_URL", "NEO_RPC_URL")
 	if neoRPCURL == "" && len(neoRPCURLs) > 0 {
 		neoRPCURL = neoRPCURLs[0]
 	}
@@ -346,21 +474,54 @@ func initChain(m *marble.Marble) (*chain.Client, string, *chain.ChainConfig) {
 	}
 
 	var chainClient *chain.Client
+	endpoints := rpcEndpoints(neoRPCURL, neoRPCURLs)
 	if neoRPCURL == "" {
 		log.Printf("Warning: NEO_RPC_URL not set; chain integration disabled")
-	} else if client, clientErr := chain.NewClient(chain.Config{
-		RPCURL:     neoRPCURL,
+	} else if client, clientErr := chain.NewPooledClient(endpoints, chain.Config{
 		NetworkID:  networkMagic,
 		HTTPClient: m.ExternalHTTPClient(),
 	}); clientErr != nil {
 		log.Printf("Warning: failed to initialize chain client: %v", clientErr)
 	} else {
+		if len(endpoints) > 1 {
+			log.Printf("Chain client using %d RPC endpoints with failover", len(endpoints))
+		}
+		feeStrategy, feeErr := chain.FeeStrategyFromEnv()
+		if feeErr != nil {
+			log.Fatalf("CRITICAL: invalid network fee configuration: %v", feeErr)
+		}
+		if feeErr = client.SetFeeStrategy(feeStrategy); feeErr != nil {
+			log.Fatalf("CRITICAL: invalid network fee configuration: %v", feeErr)
+		}
+		if raw := strings.TrimSpace(os.Getenv("NEO_TX_MAX_RESUBMITS")); raw != "" {
+			maxResubmits, parseErr := strconv.Atoi(raw)
+			if parseErr != nil || maxResubmits < 0 {
+				log.Fatalf("CRITICAL: invalid NEO_TX_MAX_RESUBMITS %q", raw)
+			}
+			client.SetMaxResubmits(maxResubmits)
+		}
 		chainClient = client
 	}
 
 	return chainClient, chainID, chainMeta
 }
 
+// rpcEndpoints returns the RPC endpoints to use, primary first, without
+// duplicates. A single entry means no failover.
+func rpcEndpoints(primary string, urls []string) []string {
+	endpoints := make([]string, 0, len(urls)+1)
+	seen := make(map[string]bool, len(urls)+1)
+	for _, u := range append([]string{primary}, urls...) {
+		u = strings.TrimRight(strings.TrimSpace(u), "/")
+		if u == "" || seen[u] {
+			continue
+		}
+		seen[u] = true
+		endpoints = append(endpoints, u)
+	}
+	return endpoints
+}
+
 func resolveContracts(chainMeta *chain.ChainConfig) chain.ContractAddresses {
 	contracts := chain.ContractAddressesFromEnv()
 	if chainMeta != nil {
@@ -444,18 +605,13 @@ func initTEESigner(ctx context.Context, m *marble.Marble, serviceType string, ch
 }
 
 func loadTEEPrivateKey(m *marble.Marble) string {
+	names := []string{"TEE_PRIVATE_KEY", "TEE_WALLET_PRIVATE_KEY"}
 	if m != nil {
-		if secret, ok := m.Secret("TEE_PRIVATE_KEY"); ok && len(secret) > 0 {
-			secretStr := strings.TrimSpace(string(secret))
-			if secretStr != "" && (secretStr[0] == 'K' || secretStr[0] == 'L' || secretStr[0] == '5') {
-				return secretStr
-			}
-			if len(secretStr) == 64 || len(secretStr) == 66 {
-				return trimHexPrefix(secretStr)
+		for _, name := range names {
+			secret, ok := m.Secret(name)
+			if !ok || len(secret) == 0 {
+				continue
 			}
-			return hex.EncodeToString(secret)
-		}
-		if secret, ok := m.Secret("TEE_WALLET_PRIVATE_KEY"); ok && len(secret) > 0 {
 			secretStr := strings.TrimSpace(string(secret))
 			if secretStr != "" && (secretStr[0] == 'K' || secretStr[0] == 'L' || secretStr[0] == '5') {
 				return secretStr
@@ -466,11 +622,10 @@ func loadTEEPrivateKey(m *marble.Marble) string {
 			return hex.EncodeToString(secret)
 		}
 	}
-	if key := strings.TrimSpace(os.Getenv("TEE_PRIVATE_KEY")); key != "" {
-		return trimHexPrefix(key)
-	}
-	if key := strings.TrimSpace(os.Getenv("TEE_WALLET_PRIVATE_KEY")); key != "" {
-		return trimHexPrefix(key)
+	for _, name := range names {
+		if key := strings.TrimSpace(os.Getenv(name)); key != "" {
+			return trimHexPrefix(key)
+		}
 	}
 	return ""
 }
@@ -555,11 +710,15 @@ func initEventListener(ctx context.Context, serviceType string, chainClient *cha
 	}
 
 	return chain.NewEventListener(&chain.ListenerConfig{
-		Client:        chainClient,
-		Contracts:     listenerContracts,
-		StartBlock:    startBlock,
-		PollInterval:  5 * time.Second,
-		Confirmations: confirmations,
+		Client:           chainClient,
+		Contracts:        listenerContracts,
+		StartBlock:       startBlock,
+		PollInterval:     5 * time.Second,
+		Confirmations:    confirmations,
+		MaxSubscribers:   runtime.ResolveInt(0, "NEO_EVENT_MAX_SUBSCRIBERS", 0),
+		SubscriberBuffer: runtime.ResolveInt(0, "NEO_EVENT_SUBSCRIBER_BUFFER", 0),
+
+		MaxHandlerConcurrency: runtime.ResolveInt(0, "NEO_EVENT_HANDLER_CONCURRENCY", 0),
 	})
 }
 
@@ -618,17 +777,57 @@ func initGasBank(m *marble.Marble, serviceType string) *gasbankclient.Client {
 	return gbClient
 }
 
+// serviceTokenPublicKeys returns the public keys to publish for service
+// tokens: the key matching SERVICE_TOKEN_PRIVATE_KEY and, while tokens signed
+// by a rotated-out key are still valid, SERVICE_TOKEN_PREVIOUS_PUBLIC_KEY.
+// Both are PEM encoded.
+func serviceTokenPublicKeys(m *marble.Marble) ([]*rsa.PublicKey, error) {
+	var keys []*rsa.PublicKey
+	if pemKey := m.SecretOrEnv("SERVICE_TOKEN_PRIVATE_KEY", "SERVICE_TOKEN_PRIVATE_KEY"); pemKey != "" {
+		priv, err := serviceauth.ParseRSAPrivateKeyFromPEM([]byte(pemKey))
+		if err != nil {
+			return nil, fmt.Errorf("SERVICE_TOKEN_PRIVATE_KEY: %w", err)
+		}
+		keys = append(keys, &priv.PublicKey)
+	}
+	if pemKey := m.SecretOrEnv("SERVICE_TOKEN_PREVIOUS_PUBLIC_KEY", "SERVICE_TOKEN_PREVIOUS_PUBLIC_KEY"); pemKey != "" {
+		pub, err := serviceauth.ParseRSAPublicKeyFromPEM([]byte(pemKey))
+		if err != nil {
+			return nil, fmt.Errorf("SERVICE_TOKEN_PREVIOUS_PUBLIC_KEY: %w", err)
+		}
+		keys = append(keys, pub)
+	}
+	return keys, nil
+}
+
 func applyMiddleware(svc Runner, serviceType string, logger *sllogging.Logger) {
 	svc.Router().Use(slmiddleware.LoggingMiddleware(logger))
 	svc.Router().Use(slmiddleware.NewRecoveryMiddleware(logger).Handler)
 	if slmetrics.Enabled() {
 		metricsCollector := slmetrics.Init(serviceType)
+		exportCircuitBreakerMetrics(serviceType, metricsCollector)
 		svc.Router().Use(slmiddleware.MetricsMiddleware(serviceType, metricsCollector))
 		svc.Router().Handle("/metrics", promhttp.Handler()).Methods(http.MethodGet)
 	}
 	svc.Router().Use(slmiddleware.NewBodyLimitMiddleware(0).Handler)
 }
 
+// exportCircuitBreakerMetrics reports the state of every named circuit breaker
+// created by the service factory, and of any created later.
+func exportCircuitBreakerMetrics(serviceType string, collector *slmetrics.Metrics) {
+	for name, state := range resilience.CircuitStates() {
+		collector.SetCircuitBreakerState(serviceType, name, int(state))
+	}
+	resilience.RegisterObserver(resilience.Observer{
+		OnStateChange: func(name string, from, to resilience.State) {
+			collector.RecordCircuitBreakerTransition(serviceType, name, from.String(), to.String(), int(to))
+		},
+		OnHalfOpenProbe: func(name string) {
+			collector.RecordCircuitBreakerProbe(serviceType, name)
+		},
+	})
+}
+
 func resolvePort(serviceType string, servicesCfg *config.ServicesConfig) string {
 	port := os.Getenv("PORT")
 	if port == "" {
@@ -0,0 +1,62 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// =============================================================================
+// Ordered Graceful Shutdown
+// =============================================================================
+
+// DefaultShutdownTimeout bounds each graceful shutdown step.
+const DefaultShutdownTimeout = 30 * time.Second
+
+// ErrShutdownTimeout is returned for a shutdown step that did not finish in time.
+var ErrShutdownTimeout = errors.New("shutdown step timed out")
+
+// shutdownStep is one component stopped during graceful shutdown. onError,
+// if set, is called when the step fails or times out.
+type shutdownStep struct {
+	name    string
+	fn      func(ctx context.Context) error
+	onError func(error)
+}
+
+// gracefulShutdown runs steps in order, giving each its own timeout. A failing
+// or slow step does not prevent later steps from running; all errors are
+// joined into the result.
+func gracefulShutdown(parent context.Context, timeout time.Duration, steps ...shutdownStep) error {
+	var errs []error
+	for _, step := range steps {
+		if err := runShutdownStep(parent, timeout, step); err != nil {
+			if step.onError != nil {
+				step.onError(err)
+			}
+			errs = append(errs, fmt.Errorf("stop %s: %w", step.name, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func runShutdownStep(parent context.Context, timeout time.Duration, step shutdownStep) error {
+	if timeout <= 0 {
+		timeout = DefaultShutdownTimeout
+	}
+	ctx, cancel := context.WithTimeout(parent, timeout)
+	defer cancel()
+
+	done := make(chan error, 1)
+	go func() {
+		done <- step.fn(ctx)
+	}()
+
+	select {
+	case err := <-done:
+		return err
+	case <-ctx.Done():
+		return fmt.Errorf("%w after %s", ErrShutdownTimeout, timeout)
+	}
+}
@@ -0,0 +1,81 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestGracefulShutdownRunsStepsInOrder(t *testing.T) {
+	var order []string
+	step := func(name string, err error) shutdownStep {
+		return shutdownStep{name: name, fn: func(context.Context) error {
+			order = append(order, name)
+			return err
+		}}
+	}
+
+	boom := errors.New("boom")
+	err := gracefulShutdown(context.Background(), time.Second,
+		step("http server", nil),
+		step("event listener", boom),
+		step("service", nil),
+	)
+
+	if len(order) != 3 || order[0] != "http server" || order[1] != "event listener" || order[2] != "service" {
+		t.Fatalf("steps ran in order %v", order)
+	}
+	if !errors.Is(err, boom) {
+		t.Fatalf("err = %v, want it to wrap %v", err, boom)
+	}
+}
+
+func TestGracefulShutdownStepTimeout(t *testing.T) {
+	release := make(chan struct{})
+	defer close(release)
+
+	ranLast := false
+	err := gracefulShutdown(context.Background(), 20*time.Millisecond,
+		shutdownStep{name: "stuck", fn: func(context.Context) error {
+			<-release
+			return nil
+		}},
+		shutdownStep{name: "service", fn: func(context.Context) error {
+			ranLast = true
+			return nil
+		}},
+	)
+
+	if !errors.Is(err, ErrShutdownTimeout) {
+		t.Fatalf("err = %v, want ErrShutdownTimeout", err)
+	}
+	if !ranLast {
+		t.Fatal("a timed out step should not prevent later steps")
+	}
+}
+
+func TestGracefulShutdownMarksStopFailed(t *testing.T) {
+	svc := NewBase(&BaseConfig{ID: "svc"})
+	svc.setState(StateStopping, nil)
+	release := make(chan struct{})
+	defer close(release)
+
+	err := gracefulShutdown(context.Background(), 20*time.Millisecond,
+		shutdownStep{
+			name: "service",
+			fn: func(context.Context) error {
+				<-release
+				return nil
+			},
+			onError: svc.MarkStopFailed,
+		},
+	)
+
+	if !errors.Is(err, ErrShutdownTimeout) {
+		t.Fatalf("err = %v, want ErrShutdownTimeout", err)
+	}
+	if desc := svc.Describe(); desc.State != StateStopFailed || desc.LastError == "" {
+		t.Fatalf("state = %q (%q), want stop_failed with the timeout error", desc.State, desc.LastError)
+	}
+}
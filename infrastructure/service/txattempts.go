@@ -0,0 +1,73 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/R3E-Network/neo-miniapps-platform/infrastructure/chain"
+	"github.com/R3E-Network/neo-miniapps-platform/infrastructure/database"
+)
+
+// =============================================================================
+// Transaction Attempt Recording
+// =============================================================================
+
+// txAttemptWriteTimeout bounds each attempt write, since recording runs inline
+// between SubmitTx attempts.
+const txAttemptWriteTimeout = 5 * time.Second
+
+// SubmitAttemptRecorder returns a chain.TxBuilder attempt recorder that writes
+// every SubmitTx attempt to the service database. An attempt that ends in
+// chain.ErrTxPermanent is stored as a dead letter instead of being retried.
+// accountID, contract and method label the attempts; contract and method are
+// overridden by the values InvokeFunctionWithSignerAndWait sets. Returns nil
+// when the service has no database.
+func (b *BaseService) SubmitAttemptRecorder(accountID, contract, method string) func(context.Context, chain.SubmitAttempt) {
+	repo := b.DB()
+	if repo == nil {
+		return nil
+	}
+	return func(ctx context.Context, a chain.SubmitAttempt) {
+		record := &database.TxAttempt{
+			ServiceID:       b.ID(),
+			AccountID:       accountID,
+			ContractAddress: contract,
+			Method:          method,
+			TxHash:          "0x" + a.TxHash.StringLE(),
+			Attempt:         a.Attempt,
+			ValidUntilBlock: a.ValidUntilBlock,
+			Nonce:           a.Nonce,
+			Status:          database.TxAttemptAccepted,
+			CreatedAt:       time.Now().UTC(),
+		}
+		if a.Contract != "" {
+			record.ContractAddress = a.Contract
+		}
+		if a.Method != "" {
+			record.Method = a.Method
+		}
+		if a.Err != nil {
+			record.Status = database.TxAttemptRejected
+			record.Error = a.Err.Error()
+		}
+		if a.Permanent {
+			record.Status = database.TxAttemptDeadLetter
+		}
+
+		logger := b.Logger().WithContext(ctx).WithFields(map[string]interface{}{
+			"tx_hash":  record.TxHash,
+			"attempt":  record.Attempt,
+			"contract": record.ContractAddress,
+			"method":   record.Method,
+		})
+		if a.Permanent {
+			logger.WithError(a.Err).Error("transaction permanently rejected; dead-lettered")
+		}
+
+		writeCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), txAttemptWriteTimeout)
+		defer cancel()
+		if err := repo.CreateTxAttempt(writeCtx, record); err != nil {
+			logger.WithError(err).Warn("failed to record transaction attempt")
+		}
+	}
+}
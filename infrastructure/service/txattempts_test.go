@@ -0,0 +1,42 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/R3E-Network/neo-miniapps-platform/infrastructure/chain"
+	"github.com/R3E-Network/neo-miniapps-platform/infrastructure/database"
+)
+
+func TestSubmitAttemptRecorderDeadLettersPermanentFailures(t *testing.T) {
+	db := database.NewMockRepository()
+	svc := NewBase(&BaseConfig{ID: "svc", DB: db})
+	record := svc.SubmitAttemptRecorder("acc-1", "0xcontract", "deploy")
+
+	rejected := errors.New("insufficient funds")
+	record(context.Background(), chain.SubmitAttempt{Attempt: 1, Err: rejected})
+	record(context.Background(), chain.SubmitAttempt{Attempt: 2, Err: rejected, Permanent: true, Method: "update"})
+
+	attempts := db.TxAttempts()
+	if len(attempts) != 2 {
+		t.Fatalf("recorded %d attempts, want 2", len(attempts))
+	}
+	if attempts[0].Status != database.TxAttemptRejected || attempts[0].Method != "deploy" {
+		t.Fatalf("first attempt = %+v, want rejected deploy", attempts[0])
+	}
+	last := attempts[1]
+	if last.Status != database.TxAttemptDeadLetter || last.ServiceID != "svc" || last.AccountID != "acc-1" {
+		t.Fatalf("last attempt = %+v, want dead letter for svc/acc-1", last)
+	}
+	if last.Method != "update" || last.Error != rejected.Error() {
+		t.Fatalf("last attempt method/error = %q/%q", last.Method, last.Error)
+	}
+}
+
+func TestSubmitAttemptRecorderWithoutDB(t *testing.T) {
+	svc := NewBase(&BaseConfig{ID: "svc"})
+	if svc.SubmitAttemptRecorder("", "", "") != nil {
+		t.Fatal("SubmitAttemptRecorder() without a database should be nil")
+	}
+}
@@ -0,0 +1,194 @@
+package service
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"log"
+	"runtime/pprof"
+	"strings"
+	"time"
+
+	slmetrics "github.com/R3E-Network/neo-miniapps-platform/infrastructure/metrics"
+)
+
+// =============================================================================
+// Lifecycle Stall Watchdog
+// =============================================================================
+
+// DefaultStallTimeout bounds how long Start or Stop may run before the
+// watchdog reports the service as stalled.
+const DefaultStallTimeout = 2 * time.Minute
+
+// TopicLifecycleStalled is the in-process topic (see Topics) that receives a
+// StallEvent whenever a lifecycle transition exceeds its stall timeout.
+const TopicLifecycleStalled = "lifecycle.stalled"
+
+// ErrTransitionStalled is returned when a lifecycle transition does not finish
+// within the stall timeout and the StallPolicyFail policy is in effect.
+var ErrTransitionStalled = errors.New("lifecycle transition stalled")
+
+// StallPolicy decides what the watchdog does once a transition has stalled.
+type StallPolicy string
+
+const (
+	// StallPolicyWarn reports the stall and keeps waiting for the transition.
+	StallPolicyWarn StallPolicy = "warn"
+	// StallPolicyFail reports the stall, marks the service failed and returns
+	// ErrTransitionStalled without waiting any longer.
+	StallPolicyFail StallPolicy = "fail"
+)
+
+// DefaultStallPolicy only reports stalls; failing is opt-in.
+const DefaultStallPolicy = StallPolicyWarn
+
+// ParseStallPolicy parses a policy name; an empty string is DefaultStallPolicy.
+func ParseStallPolicy(s string) (StallPolicy, error) {
+	switch StallPolicy(strings.ToLower(strings.TrimSpace(s))) {
+	case "":
+		return DefaultStallPolicy, nil
+	case StallPolicyWarn:
+		return StallPolicyWarn, nil
+	case StallPolicyFail:
+		return StallPolicyFail, nil
+	default:
+		return "", fmt.Errorf("unknown stall policy %q (want %q or %q)", s, StallPolicyWarn, StallPolicyFail)
+	}
+}
+
+// StallEvent describes a lifecycle transition that exceeded its stall timeout.
+type StallEvent struct {
+	Service string        `json:"service"`
+	Phase   string        `json:"phase"`
+	Timeout time.Duration `json:"timeout"`
+	Policy  StallPolicy   `json:"policy"`
+}
+
+// StallWatchdog configures RunWithStallWatchdog. A zero Timeout disables it.
+type StallWatchdog struct {
+	Timeout time.Duration
+	Policy  StallPolicy
+	// OnStall, if set, is called once when the transition stalls.
+	OnStall func(StallEvent)
+}
+
+// RunWithStallWatchdog runs a lifecycle transition (e.g. svc.Start or svc.Stop).
+// Once the watchdog's timeout elapses it logs a full goroutine dump, which is
+// usually enough to identify a deadlock, and calls OnStall. Under
+// StallPolicyWarn it then keeps waiting and returns the transition's result;
+// under StallPolicyFail it returns ErrTransitionStalled so the caller can exit
+// instead of hanging forever. The transition is never cancelled.
+func RunWithStallWatchdog(name, phase string, w StallWatchdog, fn func() error) error {
+	if w.Timeout <= 0 {
+		return fn()
+	}
+	if w.Policy == "" {
+		w.Policy = DefaultStallPolicy
+	}
+
+	done := make(chan error, 1)
+	go func() {
+		done <- fn()
+	}()
+
+	timer := time.NewTimer(w.Timeout)
+	defer timer.Stop()
+
+	select {
+	case err := <-done:
+		return err
+	case <-timer.C:
+	}
+
+	log.Printf("CRITICAL: %s %s did not complete within %s (stall policy %s); goroutine dump follows:\n%s",
+		name, phase, w.Timeout, w.Policy, goroutineDump())
+	if w.OnStall != nil {
+		w.OnStall(StallEvent{Service: name, Phase: phase, Timeout: w.Timeout, Policy: w.Policy})
+	}
+	if w.Policy == StallPolicyFail {
+		return fmt.Errorf("%s %s: %w after %s", name, phase, ErrTransitionStalled, w.Timeout)
+	}
+	return <-done
+}
+
+// WithStallWatchdog records the stall timeout and policy the runner enforces,
+// so Describe and health report stalls against the configured timeout.
+// A timeout <= 0 disables stall reporting.
+func (b *BaseService) WithStallWatchdog(timeout time.Duration, policy StallPolicy) *BaseService {
+	if policy == "" {
+		policy = DefaultStallPolicy
+	}
+	b.stateMu.Lock()
+	b.stallTimeout = timeout
+	b.stallPolicy = policy
+	b.stateMu.Unlock()
+	return b
+}
+
+// StallWatchdog returns the service's watchdog settings, wired to report
+// stalls through reportStall.
+func (b *BaseService) StallWatchdog() StallWatchdog {
+	b.stateMu.RLock()
+	defer b.stateMu.RUnlock()
+	return StallWatchdog{Timeout: b.stallTimeout, Policy: b.stallPolicy, OnStall: b.reportStall}
+}
+
+// Stalled reports whether the service is stuck in a transition for longer
+// than its configured stall timeout.
+func (b *BaseService) Stalled() (LifecycleState, time.Duration, bool) {
+	b.stateMu.RLock()
+	timeout := b.stallTimeout
+	b.stateMu.RUnlock()
+	return b.StalledIn(timeout)
+}
+
+// StalledIn reports whether the service has been in a transitional state
+// (starting or stopping) for longer than threshold.
+func (b *BaseService) StalledIn(threshold time.Duration) (LifecycleState, time.Duration, bool) {
+	b.stateMu.RLock()
+	state := b.state
+	changedAt := b.stateChangedAt
+	b.stateMu.RUnlock()
+
+	if state != StateStarting && state != StateStopping {
+		return state, 0, false
+	}
+	elapsed := time.Since(changedAt)
+	return state, elapsed, threshold > 0 && elapsed > threshold
+}
+
+// reportStall publishes the stall on TopicLifecycleStalled, counts it and,
+// under StallPolicyFail, moves the service to StateFailed (start) or
+// StateStopFailed (stop).
+func (b *BaseService) reportStall(ev StallEvent) {
+	if ev.Service == "" {
+		ev.Service = b.ID()
+	}
+	if slmetrics.Enabled() {
+		slmetrics.Global().RecordServiceStall(ev.Service, ev.Phase)
+	}
+	b.Logger().WithFields(map[string]interface{}{
+		"phase":   ev.Phase,
+		"timeout": ev.Timeout.String(),
+		"policy":  string(ev.Policy),
+	}).Error("lifecycle transition stalled")
+	if b.topics != nil {
+		b.topics.Publish(TopicLifecycleStalled, ev)
+	}
+	if ev.Policy == StallPolicyFail {
+		err := fmt.Errorf("%s: %w after %s", ev.Phase, ErrTransitionStalled, ev.Timeout)
+		if ev.Phase == "stop" {
+			b.MarkStopFailed(err)
+		} else {
+			b.setState(StateFailed, err)
+		}
+	}
+}
+
+func goroutineDump() string {
+	var buf bytes.Buffer
+	if profile := pprof.Lookup("goroutine"); profile != nil {
+		_ = profile.WriteTo(&buf, 2)
+	}
+	return buf.String()
+}
@@ -0,0 +1,134 @@
+package service
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestRunWithStallWatchdogReturnsResult(t *testing.T) {
+	want := errors.New("boom")
+	if err := RunWithStallWatchdog("svc", "start", StallWatchdog{Timeout: time.Second}, func() error { return want }); !errors.Is(err, want) {
+		t.Fatalf("err = %v, want %v", err, want)
+	}
+}
+
+func TestRunWithStallWatchdogFailPolicy(t *testing.T) {
+	release := make(chan struct{})
+	defer close(release)
+
+	var events []StallEvent
+	err := RunWithStallWatchdog("svc", "stop", StallWatchdog{
+		Timeout: 10 * time.Millisecond,
+		Policy:  StallPolicyFail,
+		OnStall: func(ev StallEvent) { events = append(events, ev) },
+	}, func() error {
+		<-release
+		return nil
+	})
+	if !errors.Is(err, ErrTransitionStalled) {
+		t.Fatalf("err = %v, want ErrTransitionStalled", err)
+	}
+	if len(events) != 1 || events[0].Phase != "stop" || events[0].Policy != StallPolicyFail {
+		t.Fatalf("events = %+v, want one stop stall", events)
+	}
+}
+
+func TestRunWithStallWatchdogWarnPolicyWaits(t *testing.T) {
+	stalled := make(chan StallEvent, 1)
+	err := RunWithStallWatchdog("svc", "start", StallWatchdog{
+		Timeout: 10 * time.Millisecond,
+		OnStall: func(ev StallEvent) { stalled <- ev },
+	}, func() error {
+		time.Sleep(50 * time.Millisecond)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("err = %v, want the transition's own result", err)
+	}
+	select {
+	case ev := <-stalled:
+		if ev.Policy != StallPolicyWarn {
+			t.Fatalf("policy = %q, want default %q", ev.Policy, StallPolicyWarn)
+		}
+	default:
+		t.Fatal("stall should be reported even when only warning")
+	}
+}
+
+func TestParseStallPolicy(t *testing.T) {
+	if p, err := ParseStallPolicy(""); err != nil || p != StallPolicyWarn {
+		t.Fatalf(`ParseStallPolicy("") = %q, %v`, p, err)
+	}
+	if p, err := ParseStallPolicy("FAIL"); err != nil || p != StallPolicyFail {
+		t.Fatalf(`ParseStallPolicy("FAIL") = %q, %v`, p, err)
+	}
+	if _, err := ParseStallPolicy("exit"); err == nil {
+		t.Fatal("unknown policy should be rejected")
+	}
+}
+
+func TestReportStall(t *testing.T) {
+	svc := NewBase(&BaseConfig{ID: "svc"})
+	events, cancel := svc.Topics().Subscribe(TopicLifecycleStalled, 1)
+	defer cancel()
+
+	svc.setState(StateStarting, nil)
+	svc.WithStallWatchdog(time.Minute, StallPolicyFail)
+	svc.StallWatchdog().OnStall(StallEvent{Service: "svc", Phase: "start", Timeout: time.Minute, Policy: StallPolicyFail})
+
+	select {
+	case msg := <-events:
+		if ev, ok := msg.Payload.(StallEvent); !ok || ev.Phase != "start" {
+			t.Fatalf("payload = %#v", msg.Payload)
+		}
+	default:
+		t.Fatal("stall event should be published")
+	}
+	if desc := svc.Describe(); desc.State != StateFailed || !strings.Contains(desc.LastError, ErrTransitionStalled.Error()) {
+		t.Fatalf("state = %q (%s), want failed under the fail policy", desc.State, desc.LastError)
+	}
+}
+
+func TestStalledIn(t *testing.T) {
+	svc := NewBase(&BaseConfig{ID: "svc"})
+	if _, _, stalled := svc.StalledIn(time.Millisecond); stalled {
+		t.Fatal("created service should not be stalled")
+	}
+
+	svc.setState(StateStopping, nil)
+	svc.stateMu.Lock()
+	svc.stateChangedAt = time.Now().Add(-time.Hour)
+	svc.stateMu.Unlock()
+
+	state, elapsed, stalled := svc.StalledIn(time.Minute)
+	if !stalled || state != StateStopping || elapsed < time.Hour {
+		t.Fatalf("StalledIn() = %q, %s, %v", state, elapsed, stalled)
+	}
+	if !svc.Describe().Stalled {
+		t.Fatal("Describe() should report stalled")
+	}
+	if svc.HealthStatus() != "unhealthy" || svc.HealthDetails()["stalled"] != true {
+		t.Fatalf("health = %q, %v; a stalled service should be unhealthy", svc.HealthStatus(), svc.HealthDetails())
+	}
+
+	// Describe and health use the configured timeout, not the default.
+	svc.WithStallWatchdog(2*time.Hour, StallPolicyWarn)
+	if svc.Describe().Stalled {
+		t.Fatal("Describe() should use the configured stall timeout")
+	}
+}
+
+func TestReportStallStopFails(t *testing.T) {
+	svc := NewBase(&BaseConfig{ID: "svc"})
+	svc.setState(StateStopping, nil)
+	svc.reportStall(StallEvent{Phase: "stop", Timeout: time.Minute, Policy: StallPolicyFail})
+
+	if got := svc.State(); got != StateStopFailed {
+		t.Fatalf("state = %q, want %q", got, StateStopFailed)
+	}
+	if _, _, stalled := svc.Stalled(); stalled {
+		t.Fatal("a stop_failed service is no longer in transition")
+	}
+}
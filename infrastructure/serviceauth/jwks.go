@@ -0,0 +1,100 @@
+package serviceauth
+
+import (
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+)
+
+// =============================================================================
+// JWKS (RFC 7517)
+// =============================================================================
+
+// JWKSPath is the conventional path for publishing the key set.
+const JWKSPath = "/.well-known/jwks.json"
+
+// JWK is the public JSON Web Key representation of an RSA verification key.
+type JWK struct {
+	Kty string `json:"kty"`
+	Use string `json:"use"`
+	Alg string `json:"alg"`
+	Kid string `json:"kid"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+}
+
+// JWKSet is a JSON Web Key Set document.
+type JWKSet struct {
+	Keys []JWK `json:"keys"`
+}
+
+// KeyID returns the RFC 7638 thumbprint of an RSA public key, used as the "kid".
+func KeyID(pub *rsa.PublicKey) string {
+	if pub == nil || pub.N == nil {
+		return ""
+	}
+	// Members in lexicographic order, no whitespace, as required by RFC 7638.
+	thumbprintInput, err := json.Marshal(struct {
+		E   string `json:"e"`
+		Kty string `json:"kty"`
+		N   string `json:"n"`
+	}{
+		E:   encodeExponent(pub.E),
+		Kty: "RSA",
+		N:   base64.RawURLEncoding.EncodeToString(pub.N.Bytes()),
+	})
+	if err != nil {
+		return ""
+	}
+	sum := sha256.Sum256(thumbprintInput)
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
+
+// PublicJWK converts an RSA public key into an RS256 signing JWK.
+func PublicJWK(pub *rsa.PublicKey) JWK {
+	if pub == nil || pub.N == nil {
+		return JWK{}
+	}
+	return JWK{
+		Kty: "RSA",
+		Use: "sig",
+		Alg: "RS256",
+		Kid: KeyID(pub),
+		N:   base64.RawURLEncoding.EncodeToString(pub.N.Bytes()),
+		E:   encodeExponent(pub.E),
+	}
+}
+
+// NewJWKSet builds a key set from the given public keys, skipping nil keys.
+// Publish old and new keys together during rotation so in-flight tokens still verify.
+func NewJWKSet(keys ...*rsa.PublicKey) JWKSet {
+	set := JWKSet{Keys: make([]JWK, 0, len(keys))}
+	for _, key := range keys {
+		if key == nil || key.N == nil {
+			continue
+		}
+		set.Keys = append(set.Keys, PublicJWK(key))
+	}
+	return set
+}
+
+// JWKSHandler serves the key set as application/json.
+func JWKSHandler(keys ...*rsa.PublicKey) http.HandlerFunc {
+	body, err := json.Marshal(NewJWKSet(keys...))
+	return func(w http.ResponseWriter, r *http.Request) {
+		if err != nil {
+			http.Error(w, "jwks unavailable", http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Cache-Control", "public, max-age=300")
+		_, _ = w.Write(body)
+	}
+}
+
+func encodeExponent(e int) string {
+	return base64.RawURLEncoding.EncodeToString(big.NewInt(int64(e)).Bytes())
+}
@@ -0,0 +1,68 @@
+package serviceauth
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+func TestKeyIDMatchesRFC7638Vector(t *testing.T) {
+	// Example key and thumbprint from RFC 7638 section 3.1.
+	var key JWK
+	raw := `{"kty":"RSA","e":"AQAB","n":"0vx7agoebGcQSuuPiLJXZptN9nndrQmbXEps2aiAFbWhM78LhWx4cbbfAAtVT86zwu1RK7aPFFxuhDR1L6tSoc_BJECPebWKRXjBZCiFV4n3oknjhMstn64tZ_2W-5JsGY4Hc5n9yBXArwl93lqt7_RN5w6Cf0h4QyQ5v-65YGjQR0_FDW2QvzqY368QQMicAtaSqzs8KJZgnYb9c7d0zgdAZHzu6qMQvRL5hajrn1n91CbOpbISD08qNLyrdkt-bFTWhAI4vMQFh6WeZu0fM4lFd2NcRwr3XPksINHaQ-G_xBniIqbw0Ls1jF44-csFCur-kEgU8awapJzKnqDKgw"}`
+	if err := json.Unmarshal([]byte(raw), &key); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	nBytes, err := base64.RawURLEncoding.DecodeString(key.N)
+	if err != nil {
+		t.Fatalf("decode n: %v", err)
+	}
+	got := KeyID(&rsa.PublicKey{N: new(big.Int).SetBytes(nBytes), E: 65537})
+	if want := "NzbLsXh8uDCcd-6MNwXF4W_7noWXFZAfHkxZsRGC9Xs"; got != want {
+		t.Fatalf("KeyID() = %q, want %q", got, want)
+	}
+}
+
+func TestJWKSHandlerAndTokenKid(t *testing.T) {
+	priv, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("generate key: %v", err)
+	}
+
+	rec := httptest.NewRecorder()
+	JWKSHandler(&priv.PublicKey, nil)(rec, httptest.NewRequest(http.MethodGet, JWKSPath, nil))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d", rec.Code, http.StatusOK)
+	}
+
+	var set JWKSet
+	if err := json.Unmarshal(rec.Body.Bytes(), &set); err != nil {
+		t.Fatalf("decode jwks: %v", err)
+	}
+	if len(set.Keys) != 1 {
+		t.Fatalf("expected 1 key, got %d", len(set.Keys))
+	}
+	jwk := set.Keys[0]
+	if jwk.Kty != "RSA" || jwk.Alg != "RS256" || jwk.E != "AQAB" {
+		t.Fatalf("unexpected jwk: %+v", jwk)
+	}
+
+	token, err := NewServiceTokenGenerator(priv, "svc", 0).GenerateToken()
+	if err != nil {
+		t.Fatalf("GenerateToken() error = %v", err)
+	}
+	parsed, _, err := jwt.NewParser().ParseUnverified(token, &ServiceClaims{})
+	if err != nil {
+		t.Fatalf("parse token: %v", err)
+	}
+	if kid, _ := parsed.Header["kid"].(string); kid != jwk.Kid {
+		t.Fatalf("token kid = %q, want %q", kid, jwk.Kid)
+	}
+}
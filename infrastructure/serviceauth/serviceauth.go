@@ -118,6 +118,10 @@ func (g *ServiceTokenGenerator) GenerateToken() (string, error) {
 	}
 
 	token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+	if g.privateKey != nil {
+		// Lets verifiers pick the matching key from a published JWKS.
+		token.Header["kid"] = KeyID(&g.privateKey.PublicKey)
+	}
 	return token.SignedString(g.privateKey)
 }
 
@@ -153,8 +157,13 @@ func (t *ServiceTokenRoundTripper) RoundTrip(req *http.Request) (*http.Response,
 	}
 	clone.Header.Set(ServiceTokenHeader, token)
 
-	if traceID := logging.GetTraceID(req.Context()); traceID != "" && clone.Header.Get("X-Trace-ID") == "" {
-		clone.Header.Set("X-Trace-ID", traceID)
+	if traceID := logging.GetTraceID(req.Context()); traceID != "" {
+		if clone.Header.Get("X-Trace-ID") == "" {
+			clone.Header.Set("X-Trace-ID", traceID)
+		}
+		if clone.Header.Get(logging.TraceparentHeader) == "" {
+			clone.Header.Set(logging.TraceparentHeader, logging.FormatTraceparent(traceID))
+		}
 	}
 
 	// Propagate user context when available.
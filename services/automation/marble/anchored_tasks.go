@@ -6,7 +6,6 @@ import (
 	"encoding/json"
 	"fmt"
 	"math/big"
-	"os"
 	"strings"
 	"sync"
 	"time"
@@ -48,12 +47,7 @@ func (s *Service) hydrateAnchoredTasks(ctx context.Context) error {
 		return nil
 	}
 
-	taskIDs := strings.TrimSpace(os.Getenv("NEOFLOW_TASK_IDS"))
-	if m := s.Marble(); m != nil {
-		if v, ok := m.Secret("NEOFLOW_TASK_IDS"); ok && len(v) > 0 {
-			taskIDs = strings.TrimSpace(string(v))
-		}
-	}
+	taskIDs := s.Marble().SecretOrEnv("NEOFLOW_TASK_IDS", "NEOFLOW_TASK_IDS")
 	if taskIDs == "" {
 		return nil
 	}
@@ -108,12 +102,12 @@ func isHex(value string) bool {
 	return true
 }
 
-func (s *Service) setupAutomationAnchorListener() {
+func (s *Service) setupAutomationAnchorListener() error {
 	if s == nil || s.eventListener == nil || s.automationAnchor == nil {
-		return
+		return nil
 	}
 
-	s.eventListener.On("TaskRegistered", func(event *chain.ContractEvent) error {
+	return s.eventListener.On("TaskRegistered", func(event *chain.ContractEvent) error {
 		parsed, err := chain.ParseAutomationAnchorTaskRegisteredEvent(event)
 		if err != nil {
 			return err
@@ -18,4 +18,5 @@ func (s *Service) registerRoutes() {
 	router.HandleFunc("/triggers/{id}/disable", s.handleDisableTrigger).Methods("POST")
 	router.HandleFunc("/triggers/{id}/executions", s.handleListExecutions).Methods("GET")
 	router.HandleFunc("/triggers/{id}/resume", s.handleResumeTrigger).Methods("POST")
+	router.HandleFunc("/triggers/{id}/run", s.handleRunTrigger).Methods("POST")
 }
@@ -1,6 +1,7 @@
 package neoflow
 
 import (
+	"context"
 	"net/http"
 	"time"
 
@@ -28,14 +29,15 @@ func (s *Service) handleListTriggers(w http.ResponseWriter, r *http.Request) {
 	for i := range triggers {
 		t := &triggers[i]
 		responses[i] = TriggerResponse{
-			ID:          t.ID,
-			Name:        t.Name,
-			TriggerType: t.TriggerType,
-			Schedule:    t.Schedule,
-			Condition:   t.Condition,
-			Action:      t.Action,
-			Enabled:     t.Enabled,
-			CreatedAt:   t.CreatedAt,
+			ID:              t.ID,
+			Name:            t.Name,
+			TriggerType:     t.TriggerType,
+			Schedule:        t.Schedule,
+			Condition:       t.Condition,
+			Action:          t.Action,
+			Enabled:         t.Enabled,
+			MissedRunPolicy: t.MissedRunPolicy,
+			CreatedAt:       t.CreatedAt,
 		}
 	}
 
@@ -69,17 +71,24 @@ func (s *Service) handleCreateTrigger(w http.ResponseWriter, r *http.Request) {
 		nextExec = next
 	}
 
+	missedRunPolicy, err := normalizeMissedRunPolicy(req.MissedRunPolicy)
+	if err != nil {
+		httputil.BadRequest(w, err.Error())
+		return
+	}
+
 	trigger := &neoflowsupabase.Trigger{
-		ID:            uuid.New().String(),
-		UserID:        userID,
-		Name:          req.Name,
-		TriggerType:   req.TriggerType,
-		Schedule:      req.Schedule,
-		Condition:     req.Condition,
-		Action:        req.Action,
-		Enabled:       true,
-		NextExecution: nextExec,
-		CreatedAt:     time.Now(),
+		ID:              uuid.New().String(),
+		UserID:          userID,
+		Name:            req.Name,
+		TriggerType:     req.TriggerType,
+		Schedule:        req.Schedule,
+		Condition:       req.Condition,
+		Action:          req.Action,
+		Enabled:         true,
+		NextExecution:   nextExec,
+		MissedRunPolicy: missedRunPolicy,
+		CreatedAt:       time.Now(),
 	}
 
 	if err := s.repo.CreateTrigger(r.Context(), trigger); err != nil {
@@ -88,13 +97,14 @@ func (s *Service) handleCreateTrigger(w http.ResponseWriter, r *http.Request) {
 	}
 
 	httputil.WriteJSON(w, http.StatusCreated, TriggerResponse{
-		ID:          trigger.ID,
-		Name:        trigger.Name,
-		TriggerType: trigger.TriggerType,
-		Schedule:    trigger.Schedule,
-		Action:      trigger.Action,
-		Enabled:     trigger.Enabled,
-		CreatedAt:   trigger.CreatedAt,
+		ID:              trigger.ID,
+		Name:            trigger.Name,
+		TriggerType:     trigger.TriggerType,
+		Schedule:        trigger.Schedule,
+		Action:          trigger.Action,
+		Enabled:         trigger.Enabled,
+		MissedRunPolicy: trigger.MissedRunPolicy,
+		CreatedAt:       trigger.CreatedAt,
 	})
 }
 
@@ -124,6 +134,12 @@ func (s *Service) handleUpdateTrigger(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	missedRunPolicy, err := normalizeMissedRunPolicy(req.MissedRunPolicy)
+	if err != nil {
+		httputil.BadRequest(w, err.Error())
+		return
+	}
+
 	trigger, err := s.repo.GetTrigger(r.Context(), id, userID)
 	if err != nil {
 		httputil.NotFound(w, "trigger not found")
@@ -135,6 +151,7 @@ func (s *Service) handleUpdateTrigger(w http.ResponseWriter, r *http.Request) {
 	trigger.Schedule = req.Schedule
 	trigger.Condition = req.Condition
 	trigger.Action = req.Action
+	trigger.MissedRunPolicy = missedRunPolicy
 
 	if trigger.TriggerType == "cron" && trigger.Schedule != "" {
 		if next, err := s.parseNextCronExecution(trigger.Schedule); err == nil {
@@ -200,15 +217,29 @@ func (s *Service) handleListExecutions(w http.ResponseWriter, r *http.Request) {
 		httputil.NotFound(w, "trigger not found")
 		return
 	}
-	limit := httputil.QueryInt(r, "limit", 50)
-	if limit > 500 {
-		limit = 500
+	page, err := httputil.ParsePageParams(r, 50, 500)
+	if err != nil {
+		httputil.BadRequest(w, err.Error())
+		return
+	}
+	execPage := neoflowsupabase.ExecutionPage{Limit: page.Limit, Offset: page.Offset}
+	if page.Cursor != nil {
+		executedAt, parseErr := time.Parse(time.RFC3339Nano, page.Cursor.SortValue)
+		if parseErr != nil {
+			httputil.BadRequest(w, "invalid cursor")
+			return
+		}
+		execPage.Before = &neoflowsupabase.ExecutionCursor{ExecutedAt: executedAt, ID: page.Cursor.ID}
 	}
-	execs, err := s.repo.GetExecutions(r.Context(), id, limit)
+	execs, err := s.repo.ListExecutions(r.Context(), id, execPage)
 	if err != nil {
 		httputil.InternalError(w, "failed to load executions")
 		return
 	}
+	if len(execs) == page.Limit {
+		last := execs[len(execs)-1]
+		w.Header().Set(httputil.NextCursorHeader, httputil.EncodeNextCursor(last.ExecutedAt.UTC().Format(time.RFC3339Nano), last.ID))
+	}
 	httputil.WriteJSON(w, http.StatusOK, execs)
 }
 
@@ -231,3 +262,47 @@ func (s *Service) handleResumeTrigger(w http.ResponseWriter, r *http.Request) {
 
 	httputil.WriteJSON(w, http.StatusOK, StatusResponse{Status: "resumed"})
 }
+
+// handleRunTrigger executes a trigger's action immediately, outside its schedule.
+// The execution is recorded like a scheduled one and shares the same concurrency
+// limit, but the trigger's next scheduled run is not moved. Disabled triggers
+// are rejected with 409.
+func (s *Service) handleRunTrigger(w http.ResponseWriter, r *http.Request) {
+	userID, ok := httputil.RequireUserID(w, r)
+	if !ok {
+		return
+	}
+	id := mux.Vars(r)["id"]
+	trigger, err := s.repo.GetTrigger(r.Context(), id, userID)
+	if err != nil {
+		httputil.NotFound(w, "trigger not found")
+		return
+	}
+	if !trigger.Enabled {
+		httputil.Conflict(w, "trigger is disabled")
+		return
+	}
+
+	if !s.tryAcquireTriggerSlot() {
+		httputil.ServiceUnavailable(w, "trigger concurrency limit reached")
+		return
+	}
+	defer s.releaseTriggerSlot()
+
+	// Detach from the request so a client disconnect does not abort the action mid-flight.
+	execCtx, cancel := context.WithTimeout(context.WithoutCancel(r.Context()), s.triggerTimeout)
+	defer cancel()
+
+	exec, runErr := s.runTrigger(execCtx, trigger, false)
+	resp := RunTriggerResponse{
+		TriggerID:   trigger.ID,
+		ExecutionID: exec.ID,
+		Success:     exec.Success,
+		ExecutedAt:  exec.ExecutedAt,
+	}
+	if runErr != nil {
+		resp.Error = runErr.Error()
+	}
+
+	httputil.WriteJSON(w, http.StatusOK, resp)
+}
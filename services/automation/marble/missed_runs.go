@@ -0,0 +1,146 @@
+package neoflow
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	neoflowsupabase "github.com/R3E-Network/neo-miniapps-platform/services/automation/supabase"
+)
+
+// =============================================================================
+// Missed-Run Policy
+// =============================================================================
+
+// Missed-run policies decide what happens to cron ticks that passed while the
+// service was not running. The trigger's persisted next_execution marks the
+// first missed tick, so no separate bookkeeping is needed across restarts.
+const (
+	// MissedRunSkip drops missed ticks and waits for the next scheduled one.
+	MissedRunSkip = "skip"
+	// MissedRunCatchUpOnce runs the trigger once for any number of missed ticks.
+	// This is the default.
+	MissedRunCatchUpOnce = "catchup-once"
+	// MissedRunCatchUpAll runs the trigger once per missed tick, up to
+	// MaxCatchUpRuns.
+	MissedRunCatchUpAll = "catchup-all"
+)
+
+const (
+	// MaxCatchUpRuns bounds how many missed ticks catchup-all replays at once.
+	MaxCatchUpRuns = 100
+
+	// missedRunGrace is how late a tick may be picked up and still count as
+	// on time rather than missed.
+	missedRunGrace = time.Minute
+)
+
+// normalizeMissedRunPolicy validates a policy from a request. An empty value
+// selects the default.
+func normalizeMissedRunPolicy(policy string) (string, error) {
+	switch policy = strings.ToLower(strings.TrimSpace(policy)); policy {
+	case "":
+		return MissedRunCatchUpOnce, nil
+	case MissedRunSkip, MissedRunCatchUpOnce, MissedRunCatchUpAll:
+		return policy, nil
+	default:
+		return "", fmt.Errorf("invalid missed_run_policy %q: must be %s, %s or %s",
+			policy, MissedRunSkip, MissedRunCatchUpOnce, MissedRunCatchUpAll)
+	}
+}
+
+// runDueTrigger executes a due cron trigger as many times as its missed-run
+// policy asks for. Each run gets an independent timeout so a slow catch-up
+// batch is not cut short by the parent worker context.
+func (s *Service) runDueTrigger(trigger *neoflowsupabase.Trigger, now time.Time) {
+	runs := s.dueRunCount(trigger, now)
+	if runs == 0 {
+		ctx, cancel := context.WithTimeout(context.Background(), s.triggerTimeout)
+		defer cancel()
+		s.skipMissedRuns(ctx, trigger)
+		return
+	}
+
+	for i := 0; i < runs; i++ {
+		execCtx, cancel := context.WithTimeout(context.Background(), s.triggerTimeout)
+		_ = s.executeTrigger(execCtx, trigger)
+		cancel()
+	}
+}
+
+// dueRunCount returns how many times trigger should run now under its
+// missed-run policy.
+func (s *Service) dueRunCount(trigger *neoflowsupabase.Trigger, now time.Time) int {
+	policy := strings.ToLower(strings.TrimSpace(trigger.MissedRunPolicy))
+	if policy == "" || policy == MissedRunCatchUpOnce || trigger.NextExecution.IsZero() {
+		return 1
+	}
+	sched, err := parseCronSchedule(trigger.Schedule)
+	if err != nil {
+		// executeTrigger logs the invalid schedule and clears next_execution.
+		return 1
+	}
+
+	switch policy {
+	case MissedRunSkip:
+		if onTimeCronRun(sched, trigger.NextExecution, now) {
+			return 1
+		}
+		return 0
+	case MissedRunCatchUpAll:
+		runs := len(dueCronRuns(sched, trigger.NextExecution, now, MaxCatchUpRuns))
+		if runs == MaxCatchUpRuns {
+			s.Logger().WithFields(map[string]interface{}{
+				"trigger_id": trigger.ID,
+				"max_runs":   MaxCatchUpRuns,
+			}).Warn("missed cron runs exceed catch-up limit; replaying the first batch only")
+		}
+		return runs
+	default:
+		return 1
+	}
+}
+
+// skipMissedRuns advances trigger past its missed ticks without executing it.
+func (s *Service) skipMissedRuns(ctx context.Context, trigger *neoflowsupabase.Trigger) {
+	next, err := s.parseNextCronExecution(trigger.Schedule)
+	if err != nil {
+		next = time.Time{}
+	}
+	s.Logger().WithContext(ctx).WithFields(map[string]interface{}{
+		"trigger_id":     trigger.ID,
+		"missed_since":   trigger.NextExecution,
+		"next_execution": next,
+	}).Info("skipping missed cron runs")
+
+	trigger.NextExecution = next
+	if err := s.repo.UpdateTrigger(ctx, trigger); err != nil {
+		s.Logger().WithContext(ctx).WithError(err).WithField("trigger_id", trigger.ID).Warn("failed to update trigger")
+	}
+}
+
+// onTimeCronRun reports whether a scheduled tick at or after first fell within
+// missedRunGrace of now.
+func onTimeCronRun(sched *cronSchedule, first, now time.Time) bool {
+	if now.Sub(first) <= missedRunGrace {
+		return true
+	}
+	next, err := sched.next(now.Add(-missedRunGrace))
+	return err == nil && !next.After(now)
+}
+
+// dueCronRuns returns the scheduled tick times from first up to now, at most
+// limit of them. first is the trigger's persisted next_execution and is
+// always included.
+func dueCronRuns(sched *cronSchedule, first, now time.Time, limit int) []time.Time {
+	runs := []time.Time{first}
+	for len(runs) < limit {
+		next, err := sched.next(runs[len(runs)-1])
+		if err != nil || next.After(now) {
+			break
+		}
+		runs = append(runs, next)
+	}
+	return runs
+}
@@ -9,7 +9,6 @@ package neoflow
 import (
 	"context"
 	"fmt"
-	"os"
 	"strings"
 	"sync"
 	"time"
@@ -183,7 +182,9 @@ func New(cfg Config) (*Service, error) {
 
 	if s.enableChainExec && s.automationAnchor != nil {
 		base.WithHydrate(s.hydrateAnchoredTasks)
-		s.setupAutomationAnchorListener()
+		if err := s.setupAutomationAnchorListener(); err != nil {
+			return nil, fmt.Errorf("neoflow: register automation anchor listener: %w", err)
+		}
 		base.AddWorker(s.runEventListener)
 	}
 
@@ -241,13 +242,7 @@ func (s *Service) schedulerHydrationUserID() string {
 		return ""
 	}
 
-	if marbleInstance := s.Marble(); marbleInstance != nil {
-		if value, ok := marbleInstance.Secret("NEOFLOW_SCHEDULER_USER_ID"); ok && len(value) > 0 {
-			return string(value)
-		}
-	}
-
-	return os.Getenv("NEOFLOW_SCHEDULER_USER_ID")
+	return s.Marble().SecretOrEnv("NEOFLOW_SCHEDULER_USER_ID", "NEOFLOW_SCHEDULER_USER_ID")
 }
 
 // statistics returns runtime statistics for the /info endpoint.
@@ -5,6 +5,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
@@ -14,6 +15,7 @@ import (
 
 	"github.com/gorilla/mux"
 
+	"github.com/R3E-Network/neo-miniapps-platform/infrastructure/httputil"
 	"github.com/R3E-Network/neo-miniapps-platform/infrastructure/marble"
 	neoflowsupabase "github.com/R3E-Network/neo-miniapps-platform/services/automation/supabase"
 )
@@ -24,8 +26,9 @@ import (
 
 // mockNeoFlowRepo implements neoflowsupabase.RepositoryInterface for testing.
 type mockNeoFlowRepo struct {
-	triggers   map[string]*neoflowsupabase.Trigger
-	executions map[string][]neoflowsupabase.Execution
+	triggers      map[string]*neoflowsupabase.Trigger
+	executions    map[string][]neoflowsupabase.Execution
+	createExecErr error
 }
 
 func newMockNeoFlowRepo() *mockNeoFlowRepo {
@@ -124,14 +127,37 @@ func (m *mockNeoFlowRepo) GetPendingTriggers(_ context.Context) ([]neoflowsupaba
 }
 
 func (m *mockNeoFlowRepo) CreateExecution(_ context.Context, exec *neoflowsupabase.Execution) error {
+	if m.createExecErr != nil {
+		return m.createExecErr
+	}
 	m.executions[exec.TriggerID] = append(m.executions[exec.TriggerID], *exec)
 	return nil
 }
 
-func (m *mockNeoFlowRepo) GetExecutions(_ context.Context, triggerID string, limit int) ([]neoflowsupabase.Execution, error) {
+func (m *mockNeoFlowRepo) GetExecutions(ctx context.Context, triggerID string, limit int) ([]neoflowsupabase.Execution, error) {
+	return m.ListExecutions(ctx, triggerID, neoflowsupabase.ExecutionPage{Limit: limit})
+}
+
+// ListExecutions pages executions in insertion order; a cursor resumes after
+// the execution with the cursor's id.
+func (m *mockNeoFlowRepo) ListExecutions(_ context.Context, triggerID string, page neoflowsupabase.ExecutionPage) ([]neoflowsupabase.Execution, error) {
 	execs := m.executions[triggerID]
-	if len(execs) > limit {
-		return execs[:limit], nil
+	start := page.Offset
+	if page.Before != nil {
+		start = len(execs)
+		for i := range execs {
+			if execs[i].ID == page.Before.ID {
+				start = i + 1
+				break
+			}
+		}
+	}
+	if start > len(execs) {
+		start = len(execs)
+	}
+	execs = execs[start:]
+	if len(execs) > page.Limit {
+		return execs[:page.Limit], nil
 	}
 	return execs, nil
 }
@@ -303,6 +329,119 @@ func TestParseNextCronExecutionInvalid(t *testing.T) {
 	}
 }
 
+// =============================================================================
+// Missed-Run Policy Tests
+// =============================================================================
+
+func TestNormalizeMissedRunPolicy(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    string
+		wantErr bool
+	}{
+		{"", MissedRunCatchUpOnce, false},
+		{"skip", MissedRunSkip, false},
+		{" CatchUp-All ", MissedRunCatchUpAll, false},
+		{"replay", "", true},
+	}
+	for _, tt := range tests {
+		got, err := normalizeMissedRunPolicy(tt.in)
+		if (err != nil) != tt.wantErr || got != tt.want {
+			t.Errorf("normalizeMissedRunPolicy(%q) = %q, %v; want %q, err=%v", tt.in, got, err, tt.want, tt.wantErr)
+		}
+	}
+}
+
+func TestDueRunCount(t *testing.T) {
+	m, _ := marble.New(marble.Config{MarbleType: "neoflow"})
+	svc, _ := New(Config{Marble: m})
+
+	now := time.Date(2026, 1, 1, 12, 30, 0, 0, time.UTC)
+	missedSince := time.Date(2026, 1, 1, 9, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name          string
+		policy        string
+		nextExecution time.Time
+		want          int
+	}{
+		{"default runs once", "", missedSince, 1},
+		{"catchup-once", MissedRunCatchUpOnce, missedSince, 1},
+		{"catchup-all replays each tick", MissedRunCatchUpAll, missedSince, 4},
+		{"skip drops missed ticks", MissedRunSkip, missedSince, 0},
+		{"skip still runs on-time tick", MissedRunSkip, now.Add(-30 * time.Second), 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			trigger := &neoflowsupabase.Trigger{
+				ID:              "trigger-1",
+				TriggerType:     "cron",
+				Schedule:        "0 * * * *",
+				NextExecution:   tt.nextExecution,
+				MissedRunPolicy: tt.policy,
+			}
+			if got := svc.dueRunCount(trigger, now); got != tt.want {
+				t.Errorf("dueRunCount() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRunDueTriggerCatchUpAll(t *testing.T) {
+	m, _ := marble.New(marble.Config{MarbleType: "neoflow"})
+	mockRepo := newMockNeoFlowRepo()
+	svc, _ := New(Config{Marble: m, NeoFlowRepo: mockRepo})
+
+	now := time.Now()
+	trigger := &neoflowsupabase.Trigger{
+		ID:              "trigger-1",
+		UserID:          "user-123",
+		TriggerType:     "cron",
+		Schedule:        "* * * * *",
+		Enabled:         true,
+		Action:          json.RawMessage(`{"type":"unknown"}`), // Unknown type - no-op
+		NextExecution:   now.Truncate(time.Minute).Add(-5 * time.Minute),
+		MissedRunPolicy: MissedRunCatchUpAll,
+	}
+	mockRepo.triggers[trigger.ID] = trigger
+
+	svc.runDueTrigger(trigger, now)
+
+	if got := len(mockRepo.executions[trigger.ID]); got != 6 {
+		t.Fatalf("executions = %d, want 6", got)
+	}
+	if !trigger.NextExecution.After(now) {
+		t.Errorf("next execution %v should be after %v", trigger.NextExecution, now)
+	}
+}
+
+func TestRunDueTriggerSkip(t *testing.T) {
+	m, _ := marble.New(marble.Config{MarbleType: "neoflow"})
+	mockRepo := newMockNeoFlowRepo()
+	svc, _ := New(Config{Marble: m, NeoFlowRepo: mockRepo})
+
+	now := time.Now()
+	trigger := &neoflowsupabase.Trigger{
+		ID:              "trigger-1",
+		UserID:          "user-123",
+		TriggerType:     "cron",
+		Schedule:        "0 0 1 1 *",
+		Enabled:         true,
+		NextExecution:   now.Add(-48 * time.Hour),
+		MissedRunPolicy: MissedRunSkip,
+	}
+	mockRepo.triggers[trigger.ID] = trigger
+
+	svc.runDueTrigger(trigger, now)
+
+	if got := len(mockRepo.executions[trigger.ID]); got != 0 {
+		t.Fatalf("executions = %d, want 0", got)
+	}
+	if !trigger.NextExecution.After(now) {
+		t.Errorf("next execution %v should be after %v", trigger.NextExecution, now)
+	}
+}
+
 // =============================================================================
 // Handler Tests
 // =============================================================================
@@ -1203,7 +1342,9 @@ func TestSetupAutomationAnchorListenerNil(t *testing.T) {
 	svc, _ := New(Config{Marble: m})
 
 	// Should return early without panic when eventListener/anchor are nil
-	svc.setupAutomationAnchorListener()
+	if err := svc.setupAutomationAnchorListener(); err != nil {
+		t.Fatalf("setupAutomationAnchorListener() error = %v", err)
+	}
 }
 
 // =============================================================================
@@ -1357,6 +1498,117 @@ func TestHandleDeleteTriggerWithMock(t *testing.T) {
 	}
 }
 
+func TestHandleRunTriggerWithMock(t *testing.T) {
+	m, _ := marble.New(marble.Config{MarbleType: "neoflow"})
+	mockRepo := newMockNeoFlowRepo()
+	mockRepo.triggers["trigger-123"] = &neoflowsupabase.Trigger{
+		ID: "trigger-123", UserID: "user-123", Name: "Test", TriggerType: "cron",
+		Schedule: "0 * * * *", Enabled: true,
+		Action:        json.RawMessage(`{"type":"unknown"}`),
+		NextExecution: time.Now().Add(time.Hour).Truncate(time.Second),
+	}
+	nextBefore := mockRepo.triggers["trigger-123"].NextExecution
+	svc, _ := New(Config{Marble: m, NeoFlowRepo: mockRepo})
+
+	req := httptest.NewRequest("POST", "/triggers/trigger-123/run", nil)
+	req.Header.Set("X-User-ID", "user-123")
+	req = mux.SetURLVars(req, map[string]string{"id": "trigger-123"})
+	rr := httptest.NewRecorder()
+
+	svc.handleRunTrigger(rr, req)
+
+	if rr.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d", rr.Code, http.StatusOK)
+	}
+	var resp RunTriggerResponse
+	if err := json.Unmarshal(rr.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("decode response: %v", err)
+	}
+	if !resp.Success || resp.TriggerID != "trigger-123" {
+		t.Errorf("unexpected response: %+v", resp)
+	}
+	if len(mockRepo.executions["trigger-123"]) != 1 {
+		t.Fatalf("expected 1 execution, got %d", len(mockRepo.executions["trigger-123"]))
+	}
+	if resp.ExecutionID == "" || resp.ExecutionID != mockRepo.executions["trigger-123"][0].ID {
+		t.Errorf("execution_id = %q, want the recorded execution's id", resp.ExecutionID)
+	}
+	if got := mockRepo.triggers["trigger-123"].NextExecution; !got.Equal(nextBefore) {
+		t.Errorf("next_execution = %v, want manual run to keep %v", got, nextBefore)
+	}
+}
+
+func TestHandleRunTriggerExecutionNotPersisted(t *testing.T) {
+	m, _ := marble.New(marble.Config{MarbleType: "neoflow"})
+	mockRepo := newMockNeoFlowRepo()
+	mockRepo.createExecErr = errors.New("insert failed")
+	mockRepo.triggers["trigger-123"] = &neoflowsupabase.Trigger{
+		ID: "trigger-123", UserID: "user-123", Name: "Test", TriggerType: "cron",
+		Schedule: "0 * * * *", Enabled: true,
+		Action: json.RawMessage(`{"type":"unknown"}`),
+	}
+	svc, _ := New(Config{Marble: m, NeoFlowRepo: mockRepo})
+
+	req := httptest.NewRequest("POST", "/triggers/trigger-123/run", nil)
+	req.Header.Set("X-User-ID", "user-123")
+	req = mux.SetURLVars(req, map[string]string{"id": "trigger-123"})
+	rr := httptest.NewRecorder()
+
+	svc.handleRunTrigger(rr, req)
+
+	if rr.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d", rr.Code, http.StatusOK)
+	}
+	var resp RunTriggerResponse
+	if err := json.Unmarshal(rr.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("decode response: %v", err)
+	}
+	if resp.ExecutionID != "" {
+		t.Errorf("execution_id = %q, want empty when the execution was not persisted", resp.ExecutionID)
+	}
+}
+
+func TestHandleRunTriggerDisabled(t *testing.T) {
+	m, _ := marble.New(marble.Config{MarbleType: "neoflow"})
+	mockRepo := newMockNeoFlowRepo()
+	mockRepo.triggers["trigger-123"] = &neoflowsupabase.Trigger{
+		ID: "trigger-123", UserID: "user-123", Name: "Test", TriggerType: "cron",
+		Schedule: "0 * * * *", Enabled: false,
+		Action: json.RawMessage(`{"type":"unknown"}`),
+	}
+	svc, _ := New(Config{Marble: m, NeoFlowRepo: mockRepo})
+
+	req := httptest.NewRequest("POST", "/triggers/trigger-123/run", nil)
+	req.Header.Set("X-User-ID", "user-123")
+	req = mux.SetURLVars(req, map[string]string{"id": "trigger-123"})
+	rr := httptest.NewRecorder()
+
+	svc.handleRunTrigger(rr, req)
+
+	if rr.Code != http.StatusConflict {
+		t.Errorf("status = %d, want %d", rr.Code, http.StatusConflict)
+	}
+	if len(mockRepo.executions["trigger-123"]) != 0 {
+		t.Error("a disabled trigger must not run")
+	}
+}
+
+func TestHandleRunTriggerNotFound(t *testing.T) {
+	m, _ := marble.New(marble.Config{MarbleType: "neoflow"})
+	svc, _ := New(Config{Marble: m, NeoFlowRepo: newMockNeoFlowRepo()})
+
+	req := httptest.NewRequest("POST", "/triggers/missing/run", nil)
+	req.Header.Set("X-User-ID", "user-123")
+	req = mux.SetURLVars(req, map[string]string{"id": "missing"})
+	rr := httptest.NewRecorder()
+
+	svc.handleRunTrigger(rr, req)
+
+	if rr.Code != http.StatusNotFound {
+		t.Errorf("status = %d, want %d", rr.Code, http.StatusNotFound)
+	}
+}
+
 func TestHandleEnableDisableTriggerWithMock(t *testing.T) {
 	m, _ := marble.New(marble.Config{MarbleType: "neoflow"})
 	mockRepo := newMockNeoFlowRepo()
@@ -1419,6 +1671,64 @@ func TestHandleListExecutionsWithMock(t *testing.T) {
 	}
 }
 
+func TestHandleListExecutionsPages(t *testing.T) {
+	m, _ := marble.New(marble.Config{MarbleType: "neoflow"})
+	mockRepo := newMockNeoFlowRepo()
+	mockRepo.triggers["trigger-123"] = &neoflowsupabase.Trigger{
+		ID: "trigger-123", UserID: "user-123", Name: "Test", TriggerType: "cron",
+	}
+	now := time.Now()
+	mockRepo.executions["trigger-123"] = []neoflowsupabase.Execution{
+		{ID: "exec-3", TriggerID: "trigger-123", ExecutedAt: now},
+		{ID: "exec-2", TriggerID: "trigger-123", ExecutedAt: now.Add(-time.Minute)},
+		{ID: "exec-1", TriggerID: "trigger-123", ExecutedAt: now.Add(-2 * time.Minute)},
+	}
+	svc, _ := New(Config{Marble: m, NeoFlowRepo: mockRepo})
+
+	list := func(query string) ([]neoflowsupabase.Execution, *httptest.ResponseRecorder) {
+		t.Helper()
+		req := httptest.NewRequest("GET", "/triggers/trigger-123/executions"+query, nil)
+		req.Header.Set("X-User-ID", "user-123")
+		req = mux.SetURLVars(req, map[string]string{"id": "trigger-123"})
+		rr := httptest.NewRecorder()
+		svc.handleListExecutions(rr, req)
+		var execs []neoflowsupabase.Execution
+		if rr.Code == http.StatusOK {
+			if err := json.Unmarshal(rr.Body.Bytes(), &execs); err != nil {
+				t.Fatalf("decode response: %v", err)
+			}
+		}
+		return execs, rr
+	}
+
+	first, rr := list("?limit=2")
+	if len(first) != 2 || first[0].ID != "exec-3" {
+		t.Fatalf("first page = %+v", first)
+	}
+	next := rr.Header().Get(httputil.NextCursorHeader)
+	if next == "" {
+		t.Fatal("a full page should carry a next cursor")
+	}
+
+	second, rr := list("?limit=2&cursor=" + next)
+	if len(second) != 1 || second[0].ID != "exec-1" {
+		t.Fatalf("second page = %+v, want exec-1", second)
+	}
+	if rr.Header().Get(httputil.NextCursorHeader) != "" {
+		t.Error("the last page should not carry a next cursor")
+	}
+
+	if byOffset, _ := list("?limit=2&offset=1"); len(byOffset) != 2 || byOffset[0].ID != "exec-2" {
+		t.Fatalf("offset page = %+v, want exec-2 first", byOffset)
+	}
+	if defaulted, rr := list("?limit=abc"); rr.Code != http.StatusOK || len(defaulted) != 3 {
+		t.Fatalf("bad limit: status %d, %d executions; want the default limit", rr.Code, len(defaulted))
+	}
+	if _, rr := list("?offset=-1"); rr.Code != http.StatusBadRequest {
+		t.Fatalf("negative offset status = %d, want 400", rr.Code)
+	}
+}
+
 func TestHandleResumeTriggerWithMock(t *testing.T) {
 	m, _ := marble.New(marble.Config{MarbleType: "neoflow"})
 	mockRepo := newMockNeoFlowRepo()
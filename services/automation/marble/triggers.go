@@ -49,17 +49,25 @@ func (s *Service) checkAndExecuteTriggers(ctx context.Context) {
 								WithField("panic", r).Error("panic recovered in trigger execution goroutine")
 						}
 					}()
-					// Create independent context with timeout to avoid parent cancellation issues
-					execCtx, cancel := context.WithTimeout(context.Background(), s.triggerTimeout)
-					defer cancel()
-					s.executeTrigger(execCtx, t)
+					s.runDueTrigger(t, now)
 				}(trigger)
 			}
 		}
 	}
 }
 
-func (s *Service) executeTrigger(ctx context.Context, trigger *neoflowsupabase.Trigger) {
+// executeTrigger dispatches the trigger action, advances its schedule and
+// persists an execution record. It returns the action dispatch error, if any.
+func (s *Service) executeTrigger(ctx context.Context, trigger *neoflowsupabase.Trigger) error {
+	_, err := s.runTrigger(ctx, trigger, true)
+	return err
+}
+
+// runTrigger dispatches the trigger action and persists an execution record,
+// which it returns along with the action dispatch error. With advanceSchedule
+// the trigger's last and next execution are updated as well; manual runs
+// leave the trigger row, and so its schedule, untouched.
+func (s *Service) runTrigger(ctx context.Context, trigger *neoflowsupabase.Trigger, advanceSchedule bool) (*neoflowsupabase.Execution, error) {
 	var actionType string
 	if len(trigger.Action) > 0 {
 		var act Action
@@ -70,39 +78,46 @@ func (s *Service) executeTrigger(ctx context.Context, trigger *neoflowsupabase.T
 
 	// Execute the action (best-effort)
 	err := s.dispatchAction(ctx, trigger.Action)
+	executedAt := time.Now()
 
 	// Update last execution and calculate next
-	trigger.LastExecution = time.Now()
-	if trigger.TriggerType == "cron" && trigger.Schedule != "" {
-		next, cronErr := s.parseNextCronExecution(trigger.Schedule)
-		if cronErr != nil {
-			s.Logger().WithContext(ctx).WithError(cronErr).WithField("trigger_id", trigger.ID).Warn("invalid cron schedule")
-			trigger.NextExecution = time.Time{}
-		} else {
-			trigger.NextExecution = next
+	if advanceSchedule {
+		trigger.LastExecution = executedAt
+		if trigger.TriggerType == "cron" && trigger.Schedule != "" {
+			next, cronErr := s.parseNextCronExecution(trigger.Schedule)
+			if cronErr != nil {
+				s.Logger().WithContext(ctx).WithError(cronErr).WithField("trigger_id", trigger.ID).Warn("invalid cron schedule")
+				trigger.NextExecution = time.Time{}
+			} else {
+				trigger.NextExecution = next
+			}
+		}
+		if updateErr := s.repo.UpdateTrigger(ctx, trigger); updateErr != nil {
+			s.Logger().WithContext(ctx).WithError(updateErr).WithField("trigger_id", trigger.ID).Warn("failed to update trigger")
 		}
 	}
-	if updateErr := s.repo.UpdateTrigger(ctx, trigger); updateErr != nil {
-		s.Logger().WithContext(ctx).WithError(updateErr).WithField("trigger_id", trigger.ID).Warn("failed to update trigger")
+
+	exec := &neoflowsupabase.Execution{
+		ID:            uuid.New().String(),
+		TriggerID:     trigger.ID,
+		ExecutedAt:    executedAt,
+		Success:       err == nil,
+		ActionType:    actionType,
+		ActionPayload: trigger.Action,
+	}
+	if err != nil {
+		exec.Error = err.Error()
 	}
 
 	// Persist execution log
 	if s.repo != nil {
-		exec := &neoflowsupabase.Execution{
-			ID:            uuid.New().String(),
-			TriggerID:     trigger.ID,
-			ExecutedAt:    trigger.LastExecution,
-			Success:       err == nil,
-			ActionType:    actionType,
-			ActionPayload: trigger.Action,
-		}
-		if err != nil {
-			exec.Error = err.Error()
-		}
 		if execErr := s.repo.CreateExecution(ctx, exec); execErr != nil {
 			s.Logger().WithContext(ctx).WithError(execErr).WithField("trigger_id", trigger.ID).Warn("failed to persist execution log")
+			// No execution record exists, so do not hand out an ID that resolves to nothing.
+			exec.ID = ""
 		}
 	}
+	return exec, err
 }
 
 func (s *Service) dispatchAction(ctx context.Context, actionRaw json.RawMessage) error {
@@ -296,45 +311,68 @@ func isMeshHostname(rawHost string) bool {
 // Supports standard 5-field cron: minute hour day-of-month month day-of-week
 // Supports: specific values (5), wildcards (*), ranges (1-5), lists (1,3,5), steps (*/15)
 func (s *Service) parseNextCronExecution(cronExpr string) (time.Time, error) {
+	sched, err := parseCronSchedule(cronExpr)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return sched.next(time.Now())
+}
+
+// cronSchedule is a parsed 5-field cron expression.
+type cronSchedule struct {
+	minutes, hours, days, months, weekdays map[int]bool
+}
+
+func parseCronSchedule(cronExpr string) (*cronSchedule, error) {
 	parts := strings.Fields(cronExpr)
 	if len(parts) != 5 {
-		return time.Time{}, fmt.Errorf("invalid cron expression: expected 5 fields")
+		return nil, fmt.Errorf("invalid cron expression: expected 5 fields")
 	}
 
 	// Parse each field into allowed values
 	minutes, err := parseCronField(parts[0], 0, 59)
 	if err != nil {
-		return time.Time{}, fmt.Errorf("invalid minute field: %w", err)
+		return nil, fmt.Errorf("invalid minute field: %w", err)
 	}
 	hours, err := parseCronField(parts[1], 0, 23)
 	if err != nil {
-		return time.Time{}, fmt.Errorf("invalid hour field: %w", err)
+		return nil, fmt.Errorf("invalid hour field: %w", err)
 	}
 	days, err := parseCronField(parts[2], 1, 31)
 	if err != nil {
-		return time.Time{}, fmt.Errorf("invalid day field: %w", err)
+		return nil, fmt.Errorf("invalid day field: %w", err)
 	}
 	months, err := parseCronField(parts[3], 1, 12)
 	if err != nil {
-		return time.Time{}, fmt.Errorf("invalid month field: %w", err)
+		return nil, fmt.Errorf("invalid month field: %w", err)
 	}
 	weekdays, err := parseCronField(parts[4], 0, 6)
 	if err != nil {
-		return time.Time{}, fmt.Errorf("invalid weekday field: %w", err)
+		return nil, fmt.Errorf("invalid weekday field: %w", err)
 	}
 
+	return &cronSchedule{
+		minutes:  minutes,
+		hours:    hours,
+		days:     days,
+		months:   months,
+		weekdays: weekdays,
+	}, nil
+}
+
+// next returns the first matching minute strictly after the minute containing after.
+func (c *cronSchedule) next(after time.Time) (time.Time, error) {
 	// Find next matching time (search up to 1 year ahead)
-	now := time.Now()
-	candidate := time.Date(now.Year(), now.Month(), now.Day(), now.Hour(), now.Minute(), 0, 0, now.Location())
+	candidate := time.Date(after.Year(), after.Month(), after.Day(), after.Hour(), after.Minute(), 0, 0, after.Location())
 	candidate = candidate.Add(time.Minute) // Start from next minute
 
 	maxIterations := 525600 // 1 year in minutes
 	for i := 0; i < maxIterations; i++ {
-		if months[int(candidate.Month())] &&
-			days[candidate.Day()] &&
-			weekdays[int(candidate.Weekday())] &&
-			hours[candidate.Hour()] &&
-			minutes[candidate.Minute()] {
+		if c.months[int(candidate.Month())] &&
+			c.days[candidate.Day()] &&
+			c.weekdays[int(candidate.Weekday())] &&
+			c.hours[candidate.Hour()] &&
+			c.minutes[candidate.Minute()] {
 			return candidate, nil
 		}
 		candidate = candidate.Add(time.Minute)
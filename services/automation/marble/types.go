@@ -7,25 +7,27 @@ import (
 
 // TriggerRequest is the request body for creating/updating triggers.
 type TriggerRequest struct {
-	Name        string          `json:"name"`
-	TriggerType string          `json:"trigger_type"`
-	Schedule    string          `json:"schedule,omitempty"`
-	Condition   json.RawMessage `json:"condition,omitempty"`
-	Action      json.RawMessage `json:"action"`
+	Name            string          `json:"name"`
+	TriggerType     string          `json:"trigger_type"`
+	Schedule        string          `json:"schedule,omitempty"`
+	Condition       json.RawMessage `json:"condition,omitempty"`
+	Action          json.RawMessage `json:"action"`
+	MissedRunPolicy string          `json:"missed_run_policy,omitempty"`
 }
 
 // TriggerResponse is the response body for trigger operations.
 type TriggerResponse struct {
-	ID            string          `json:"id"`
-	Name          string          `json:"name"`
-	TriggerType   string          `json:"trigger_type"`
-	Schedule      string          `json:"schedule,omitempty"`
-	Condition     json.RawMessage `json:"condition,omitempty"`
-	Action        json.RawMessage `json:"action"`
-	Enabled       bool            `json:"enabled"`
-	LastExecution *time.Time      `json:"last_execution,omitempty"`
-	NextExecution *time.Time      `json:"next_execution,omitempty"`
-	CreatedAt     time.Time       `json:"created_at"`
+	ID              string          `json:"id"`
+	Name            string          `json:"name"`
+	TriggerType     string          `json:"trigger_type"`
+	Schedule        string          `json:"schedule,omitempty"`
+	Condition       json.RawMessage `json:"condition,omitempty"`
+	Action          json.RawMessage `json:"action"`
+	Enabled         bool            `json:"enabled"`
+	LastExecution   *time.Time      `json:"last_execution,omitempty"`
+	NextExecution   *time.Time      `json:"next_execution,omitempty"`
+	MissedRunPolicy string          `json:"missed_run_policy,omitempty"`
+	CreatedAt       time.Time       `json:"created_at"`
 }
 
 // Action describes a trigger action payload.
@@ -55,3 +57,12 @@ type ThresholdCondition struct {
 type StatusResponse struct {
 	Status string `json:"status"`
 }
+
+// RunTriggerResponse is returned by the manual run endpoint.
+type RunTriggerResponse struct {
+	TriggerID   string    `json:"trigger_id"`
+	ExecutionID string    `json:"execution_id,omitempty"` // empty if the execution log was not persisted
+	Success     bool      `json:"success"`
+	Error       string    `json:"error,omitempty"`
+	ExecutedAt  time.Time `json:"executed_at"`
+}
@@ -8,17 +8,18 @@ import (
 
 // Trigger represents an neoflow trigger.
 type Trigger struct {
-	ID            string          `json:"id"`
-	UserID        string          `json:"user_id"`
-	Name          string          `json:"name"`
-	TriggerType   string          `json:"trigger_type"`
-	Schedule      string          `json:"schedule,omitempty"`
-	Condition     json.RawMessage `json:"condition,omitempty"`
-	Action        json.RawMessage `json:"action"`
-	Enabled       bool            `json:"enabled"`
-	LastExecution time.Time       `json:"last_execution,omitempty"`
-	NextExecution time.Time       `json:"next_execution,omitempty"`
-	CreatedAt     time.Time       `json:"created_at"`
+	ID              string          `json:"id"`
+	UserID          string          `json:"user_id"`
+	Name            string          `json:"name"`
+	TriggerType     string          `json:"trigger_type"`
+	Schedule        string          `json:"schedule,omitempty"`
+	Condition       json.RawMessage `json:"condition,omitempty"`
+	Action          json.RawMessage `json:"action"`
+	Enabled         bool            `json:"enabled"`
+	LastExecution   time.Time       `json:"last_execution,omitempty"`
+	NextExecution   time.Time       `json:"next_execution,omitempty"`
+	MissedRunPolicy string          `json:"missed_run_policy,omitempty"`
+	CreatedAt       time.Time       `json:"created_at"`
 }
 
 // Execution represents an execution log entry.
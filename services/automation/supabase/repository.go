@@ -4,6 +4,7 @@ package supabase
 import (
 	"context"
 	"fmt"
+	"net/url"
 	"time"
 
 	"github.com/R3E-Network/neo-miniapps-platform/infrastructure/database"
@@ -28,6 +29,7 @@ type RepositoryInterface interface {
 	// Execution Operations
 	CreateExecution(ctx context.Context, exec *Execution) error
 	GetExecutions(ctx context.Context, triggerID string, limit int) ([]Execution, error)
+	ListExecutions(ctx context.Context, triggerID string, page ExecutionPage) ([]Execution, error)
 }
 
 // Ensure Repository implements RepositoryInterface
@@ -182,20 +184,53 @@ func (r *Repository) CreateExecution(ctx context.Context, exec *Execution) error
 	})
 }
 
+// ExecutionPage selects a page of a trigger's executions, newest first.
+// When Before is set the page starts after that keyset position and Offset
+// is ignored.
+type ExecutionPage struct {
+	Limit  int
+	Offset int
+	Before *ExecutionCursor
+}
+
+// ExecutionCursor is the (executed_at, id) position of the last execution of
+// the previous page.
+type ExecutionCursor struct {
+	ExecutedAt time.Time
+	ID         string
+}
+
 // GetExecutions lists executions for a trigger.
 func (r *Repository) GetExecutions(ctx context.Context, triggerID string, limit int) ([]Execution, error) {
+	return r.ListExecutions(ctx, triggerID, ExecutionPage{Limit: limit})
+}
+
+// ListExecutions lists a page of executions for a trigger, ordered by
+// executed_at and then id, newest first.
+func (r *Repository) ListExecutions(ctx context.Context, triggerID string, page ExecutionPage) ([]Execution, error) {
 	if triggerID == "" {
 		return nil, fmt.Errorf("trigger_id cannot be empty")
 	}
+	limit := page.Limit
 	if limit <= 0 || limit > 1000 {
 		limit = 50
 	}
 
-	query := database.NewQuery().
-		Eq("trigger_id", triggerID).
+	query := database.NewQuery().Eq("trigger_id", triggerID)
+	if page.Before != nil {
+		at := url.QueryEscape(page.Before.ExecutedAt.UTC().Format(time.RFC3339Nano))
+		id := url.QueryEscape(page.Before.ID)
+		query = query.Or(
+			"executed_at.lt."+at,
+			fmt.Sprintf("and(executed_at.eq.%s,id.lt.%s)", at, id),
+		)
+	} else if page.Offset > 0 {
+		query = query.Offset(page.Offset)
+	}
+
+	return database.GenericListWithQuery[Execution](r.base, ctx, executionsTable, query.
 		OrderDesc("executed_at").
+		ThenDesc("id").
 		Limit(limit).
-		Build()
-
-	return database.GenericListWithQuery[Execution](r.base, ctx, executionsTable, query)
+		Build())
 }
@@ -6,6 +6,7 @@ import (
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 	"time"
 
@@ -374,6 +375,39 @@ func TestGetExecutions_DefaultLimit(t *testing.T) {
 	}
 }
 
+func TestListExecutions_Pages(t *testing.T) {
+	var queries []string
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		queries = append(queries, r.URL.RawQuery)
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode([]Execution{})
+	}
+	repo, server := newTestRepository(t, handler)
+	defer server.Close()
+
+	if _, err := repo.ListExecutions(context.Background(), "t1", ExecutionPage{Limit: 20, Offset: 40}); err != nil {
+		t.Fatalf("ListExecutions(offset) error = %v", err)
+	}
+	before := &ExecutionCursor{ExecutedAt: time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC), ID: "e9"}
+	if _, err := repo.ListExecutions(context.Background(), "t1", ExecutionPage{Limit: 20, Offset: 40, Before: before}); err != nil {
+		t.Fatalf("ListExecutions(cursor) error = %v", err)
+	}
+
+	if len(queries) != 2 {
+		t.Fatalf("queries = %v", queries)
+	}
+	if !strings.Contains(queries[0], "offset=40") || !strings.Contains(queries[0], "limit=20") {
+		t.Errorf("offset query = %q", queries[0])
+	}
+	keyset := "or=(executed_at.lt.2025-01-02T03%3A04%3A05Z,and(executed_at.eq.2025-01-02T03%3A04%3A05Z,id.lt.e9))"
+	if !strings.Contains(queries[1], keyset) || strings.Contains(queries[1], "offset=") {
+		t.Errorf("cursor query = %q, want keyset filter %q and no offset", queries[1], keyset)
+	}
+	if !strings.Contains(queries[1], "order=executed_at.desc,id.desc") {
+		t.Errorf("cursor query = %q, want a stable (executed_at, id) order", queries[1])
+	}
+}
+
 // =============================================================================
 // Model Tests
 // =============================================================================
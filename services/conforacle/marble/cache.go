@@ -0,0 +1,104 @@
+package neooracle
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+)
+
+// =============================================================================
+// Response Cache
+// =============================================================================
+
+const (
+	// DefaultMaxCacheTTL caps the cache_ttl_seconds a query may ask for.
+	DefaultMaxCacheTTL = 5 * time.Minute
+
+	// maxCacheEntries bounds memory use; new responses are not cached once
+	// the cache is full of unexpired entries.
+	maxCacheEntries = 1024
+)
+
+type cachedResponse struct {
+	statusCode int
+	headers    map[string]string
+	body       []byte
+	expiresAt  time.Time
+}
+
+// responseCacheKey hashes the fully resolved upstream request. Secrets are
+// interpolated before hashing, so callers only share an entry when they would
+// send byte-identical requests, and no secret is kept in the key.
+func responseCacheKey(method, rawURL string, headers http.Header, body string) string {
+	h := sha256.New()
+	write := func(s string) {
+		h.Write([]byte(s))
+		h.Write([]byte{0})
+	}
+	write(method)
+	write(rawURL)
+
+	keys := make([]string, 0, len(headers))
+	for k := range headers {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		write(k)
+		write(strings.Join(headers[k], ","))
+	}
+	write(body)
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// cacheTTL returns how long a query's response may be cached, or 0 when it
+// must not be.
+func (s *Service) cacheTTL(seconds int) time.Duration {
+	if seconds <= 0 || s.maxCacheTTL <= 0 {
+		return 0
+	}
+	ttl := time.Duration(seconds) * time.Second
+	if ttl > s.maxCacheTTL {
+		ttl = s.maxCacheTTL
+	}
+	return ttl
+}
+
+func (s *Service) getCachedResponse(key string) (cachedResponse, bool) {
+	s.cacheMu.RLock()
+	entry, ok := s.cache[key]
+	s.cacheMu.RUnlock()
+	if !ok {
+		return cachedResponse{}, false
+	}
+	if !time.Now().Before(entry.expiresAt) {
+		s.cacheMu.Lock()
+		if current, exists := s.cache[key]; exists && !time.Now().Before(current.expiresAt) {
+			delete(s.cache, key)
+		}
+		s.cacheMu.Unlock()
+		return cachedResponse{}, false
+	}
+	return entry, true
+}
+
+func (s *Service) setCachedResponse(key string, entry cachedResponse) {
+	s.cacheMu.Lock()
+	defer s.cacheMu.Unlock()
+
+	if _, exists := s.cache[key]; !exists && len(s.cache) >= maxCacheEntries {
+		now := time.Now()
+		for k, e := range s.cache {
+			if !now.Before(e.expiresAt) {
+				delete(s.cache, k)
+			}
+		}
+		if len(s.cache) >= maxCacheEntries {
+			return
+		}
+	}
+	s.cache[key] = entry
+}
@@ -3,12 +3,15 @@ package neooracle
 
 import (
 	"bytes"
+	"errors"
 	"io"
 	"net/http"
 	"net/url"
 	"strings"
+	"time"
 
 	"github.com/google/uuid"
+	"github.com/tidwall/gjson"
 
 	"github.com/R3E-Network/neo-miniapps-platform/infrastructure/httputil"
 )
@@ -33,14 +36,28 @@ func (s *Service) handleQuery(w http.ResponseWriter, r *http.Request) {
 		httputil.BadRequest(w, "url required")
 		return
 	}
+
+	// Interpolate {{secret:NAME}} placeholders before validating the URL so a
+	// templated host is checked against the allowlist too.
+	secrets := s.newSecretResolver(r.Context(), userID)
+	rawURL, inputHeaders, inputBody := input.URL, input.Headers, input.Body
+	if hasSecretPlaceholders(&input) {
+		var err error
+		rawURL, inputHeaders, inputBody, err = secrets.expandInput(&input)
+		if err != nil {
+			s.writeSecretError(w, r, err)
+			return
+		}
+	}
+
 	if httputil.StrictIdentityMode() {
-		parsed, err := url.Parse(input.URL)
+		parsed, err := url.Parse(rawURL)
 		if err != nil || parsed.Scheme == "" || parsed.Host == "" || !strings.EqualFold(parsed.Scheme, "https") {
 			httputil.BadRequest(w, "only https urls are allowed in strict identity mode")
 			return
 		}
 	}
-	if !s.allowlist.Allows(input.URL) {
+	if !s.allowlist.Allows(rawURL) {
 		httputil.BadRequest(w, "url not allowed")
 		return
 	}
@@ -50,21 +67,15 @@ func (s *Service) handleQuery(w http.ResponseWriter, r *http.Request) {
 	}
 
 	headers := make(http.Header)
-	for k, v := range input.Headers {
+	for k, v := range inputHeaders {
 		headers.Set(k, v)
 	}
 
 	// If a secret is requested, fetch it over mTLS and inject.
 	if input.SecretName != "" {
-		if s.secretProvider == nil {
-			httputil.ServiceUnavailable(w, "secret store not configured")
-			return
-		}
-		secret, err := s.secretProvider.GetSecret(r.Context(), userID, input.SecretName)
+		secret, err := secrets.get(input.SecretName)
 		if err != nil {
-			// SECURITY: Do not leak internal error details to client
-			s.Logger().WithContext(r.Context()).WithError(err).Error("failed to fetch secret")
-			httputil.InternalError(w, "failed to fetch secret")
+			s.writeSecretError(w, r, err)
 			return
 		}
 		key := input.SecretAsKey
@@ -76,11 +87,11 @@ func (s *Service) handleQuery(w http.ResponseWriter, r *http.Request) {
 	}
 
 	var body io.Reader
-	if input.Body != "" {
-		body = bytes.NewBufferString(input.Body)
+	if inputBody != "" {
+		body = bytes.NewBufferString(inputBody)
 	}
 
-	req, err := http.NewRequestWithContext(r.Context(), method, input.URL, body)
+	req, err := http.NewRequestWithContext(r.Context(), method, rawURL, body)
 	if err != nil {
 		// SECURITY: Do not leak internal error details to client
 		s.Logger().WithContext(r.Context()).WithError(err).Error("failed to create upstream request")
@@ -88,6 +99,17 @@ func (s *Service) handleQuery(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 	req.Header = headers
+
+	// Serve byte-identical requests from the cache within the caller's TTL.
+	ttl := s.cacheTTL(input.CacheTTLSeconds)
+	cacheKey := ""
+	if ttl > 0 {
+		cacheKey = responseCacheKey(method, rawURL, headers, inputBody)
+		if cached, ok := s.getCachedResponse(cacheKey); ok {
+			s.writeQueryResponse(w, r, &input, cached.statusCode, cached.headers, cached.body, true)
+			return
+		}
+	}
 	req.Header.Set("X-Request-ID", uuid.New().String())
 
 	resp, err := s.httpClient.Do(req)
@@ -120,9 +142,49 @@ func (s *Service) handleQuery(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
-	httputil.WriteJSON(w, http.StatusOK, QueryResponse{
-		StatusCode: resp.StatusCode,
-		Headers:    outHeaders,
-		Body:       string(respBody),
-	})
+	if cacheKey != "" && resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		s.setCachedResponse(cacheKey, cachedResponse{
+			statusCode: resp.StatusCode,
+			headers:    outHeaders,
+			body:       respBody,
+			expiresAt:  time.Now().Add(ttl),
+		})
+	}
+
+	s.writeQueryResponse(w, r, &input, resp.StatusCode, outHeaders, respBody, false)
+}
+
+// writeQueryResponse writes an upstream (or cached) response, extracting
+// input.JSONPath when requested.
+func (s *Service) writeQueryResponse(w http.ResponseWriter, r *http.Request, input *QueryInput, statusCode int, headers map[string]string, body []byte, cached bool) {
+	out := QueryResponse{
+		StatusCode: statusCode,
+		Headers:    headers,
+		Body:       string(body),
+		Cached:     cached,
+	}
+	if input.JSONPath != "" {
+		value := gjson.GetBytes(body, input.JSONPath)
+		if !value.Exists() {
+			httputil.WriteErrorResponse(w, r, http.StatusBadGateway, "", "json_path not found in upstream response", map[string]any{
+				"json_path": input.JSONPath,
+			})
+			return
+		}
+		out.JSONPath = input.JSONPath
+		out.Value = value.Value()
+	}
+
+	httputil.WriteJSON(w, http.StatusOK, out)
+}
+
+// writeSecretError reports a failure to resolve a user secret.
+func (s *Service) writeSecretError(w http.ResponseWriter, r *http.Request, err error) {
+	if errors.Is(err, errSecretStoreNotConfigured) {
+		httputil.ServiceUnavailable(w, "secret store not configured")
+		return
+	}
+	// SECURITY: Do not leak internal error details to client
+	s.Logger().WithContext(r.Context()).WithError(err).Error("failed to fetch secret")
+	httputil.InternalError(w, "failed to fetch secret")
 }
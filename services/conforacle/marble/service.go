@@ -4,6 +4,7 @@ package neooracle
 import (
 	"fmt"
 	"net/http"
+	"sync"
 	"time"
 
 	"github.com/R3E-Network/neo-miniapps-platform/infrastructure/httputil"
@@ -25,6 +26,10 @@ type Service struct {
 	httpClient     *http.Client
 	maxBodyBytes   int64
 	allowlist      URLAllowlist
+
+	maxCacheTTL time.Duration
+	cacheMu     sync.RWMutex
+	cache       map[string]cachedResponse
 }
 
 // Config configures the oracle.
@@ -34,6 +39,9 @@ type Config struct {
 	MaxBodyBytes   int64        // optional response cap; default 2MB
 	URLAllowlist   URLAllowlist // optional allowlist for outbound fetch
 	Timeout        time.Duration
+	// MaxCacheTTL caps per-query response caching; 0 uses DefaultMaxCacheTTL
+	// and a negative value disables caching.
+	MaxCacheTTL time.Duration
 }
 
 // New creates a new NeoOracle service.
@@ -71,12 +79,19 @@ func New(cfg Config) (*Service, error) {
 		timeout = 20 * time.Second
 	}
 
+	maxCacheTTL := cfg.MaxCacheTTL
+	if maxCacheTTL == 0 {
+		maxCacheTTL = DefaultMaxCacheTTL
+	}
+
 	s := &Service{
 		BaseService:    base,
 		secretProvider: cfg.SecretProvider,
 		httpClient:     httputil.CopyHTTPClientWithTimeout(cfg.Marble.ExternalHTTPClient(), timeout, true),
-		maxBodyBytes: maxBytes,
-		allowlist:    cfg.URLAllowlist,
+		maxBodyBytes:   maxBytes,
+		allowlist:      cfg.URLAllowlist,
+		maxCacheTTL:    maxCacheTTL,
+		cache:          make(map[string]cachedResponse),
 	}
 
 	base.RegisterStandardRoutes()
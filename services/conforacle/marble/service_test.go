@@ -1,7 +1,10 @@
 package neooracle
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
+	"io"
 	"net/http"
 	"net/http/httptest"
 	"strings"
@@ -52,6 +55,125 @@ func TestBodyLimitApplied(t *testing.T) {
 	}
 }
 
+type fakeSecrets map[string]string
+
+func (f fakeSecrets) GetSecret(_ context.Context, _, name string) (string, error) {
+	v, ok := f[name]
+	if !ok {
+		return "", errors.New("secret not found")
+	}
+	return v, nil
+}
+
+func TestQuerySecretTemplatesAndJSONPath(t *testing.T) {
+	up := testutil.NewHTTPTestServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		if r.Method != http.MethodPost || r.Header.Get("X-Api-Key") != "k3y" ||
+			string(body) != `{"token":"t0k"}` || r.URL.Query().Get("sig") != "a b" {
+			w.WriteHeader(http.StatusTeapot)
+			return
+		}
+		w.Write([]byte(`{"data":{"price":42.5}}`))
+	}))
+	defer up.Close()
+
+	svc := newTestOracle(t, URLAllowlist{Prefixes: []string{up.URL}})
+	svc.secretProvider = fakeSecrets{"key": "k3y", "token": "t0k", "sig": "a b"}
+
+	body := `{"url":"` + up.URL + `/q?sig={{secret:sig}}","method":"post",` +
+		`"headers":{"X-Api-Key":"{{ secret:key }}"},` +
+		`"body":"{\"token\":\"{{secret:token}}\"}","json_path":"data.price"}`
+	req := httptest.NewRequest("POST", "/query", strings.NewReader(body))
+	req.Header.Set("X-User-ID", "user1")
+	rr := httptest.NewRecorder()
+	svc.handleQuery(rr, req)
+	if rr.Code != http.StatusOK {
+		t.Fatalf("status=%d body=%s", rr.Code, rr.Body.String())
+	}
+
+	var resp QueryResponse
+	if err := json.NewDecoder(rr.Body).Decode(&resp); err != nil {
+		t.Fatalf("decode response: %v", err)
+	}
+	if resp.StatusCode != http.StatusOK || resp.JSONPath != "data.price" || resp.Value != 42.5 {
+		t.Fatalf("response = %+v", resp)
+	}
+}
+
+func TestQueryTemplatedHostMustBeAllowed(t *testing.T) {
+	svc := newTestOracle(t, URLAllowlist{Prefixes: []string{"https://allowed.example"}})
+	svc.secretProvider = fakeSecrets{"host": "forbidden.example"}
+
+	body := `{"url":"https://{{secret:host}}/data"}`
+	req := httptest.NewRequest("POST", "/query", strings.NewReader(body))
+	req.Header.Set("X-User-ID", "user1")
+	rr := httptest.NewRecorder()
+	svc.handleQuery(rr, req)
+	if rr.Code != http.StatusBadRequest {
+		t.Fatalf("status=%d want 400", rr.Code)
+	}
+}
+
+func TestQueryJSONPathNotFound(t *testing.T) {
+	up := testutil.NewHTTPTestServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"data":{}}`))
+	}))
+	defer up.Close()
+
+	svc := newTestOracle(t, URLAllowlist{Prefixes: []string{up.URL}})
+	body := `{"url":"` + up.URL + `","json_path":"data.price"}`
+	req := httptest.NewRequest("POST", "/query", strings.NewReader(body))
+	req.Header.Set("X-User-ID", "user1")
+	rr := httptest.NewRecorder()
+	svc.handleQuery(rr, req)
+	if rr.Code != http.StatusBadGateway {
+		t.Fatalf("status=%d want 502", rr.Code)
+	}
+}
+
+func TestQueryResponseCache(t *testing.T) {
+	hits := 0
+	up := testutil.NewHTTPTestServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits++
+		w.Write([]byte(`{"price":1}`))
+	}))
+	defer up.Close()
+
+	svc := newTestOracle(t, URLAllowlist{Prefixes: []string{up.URL}})
+	query := func(body string) QueryResponse {
+		t.Helper()
+		req := httptest.NewRequest("POST", "/query", strings.NewReader(body))
+		req.Header.Set("X-User-ID", "user1")
+		rr := httptest.NewRecorder()
+		svc.handleQuery(rr, req)
+		if rr.Code != http.StatusOK {
+			t.Fatalf("status=%d body=%s", rr.Code, rr.Body.String())
+		}
+		var resp QueryResponse
+		if err := json.NewDecoder(rr.Body).Decode(&resp); err != nil {
+			t.Fatalf("decode response: %v", err)
+		}
+		return resp
+	}
+
+	cachedQuery := `{"url":"` + up.URL + `","cache_ttl_seconds":60}`
+	if first := query(cachedQuery); first.Cached {
+		t.Fatal("first query should not be cached")
+	}
+	if second := query(cachedQuery); !second.Cached || second.Body != `{"price":1}` {
+		t.Fatalf("second query = %+v, want cached body", second)
+	}
+	if other := query(`{"url":"` + up.URL + `","method":"POST","cache_ttl_seconds":60}`); other.Cached {
+		t.Fatal("a different request must not share the cache entry")
+	}
+	if uncached := query(`{"url":"` + up.URL + `"}`); uncached.Cached {
+		t.Fatal("queries without a TTL must bypass the cache")
+	}
+	if hits != 3 {
+		t.Fatalf("upstream hits = %d, want 3", hits)
+	}
+}
+
 // newTestOracle returns a service with minimal deps; secrets client won't be used.
 func newTestOracle(t *testing.T, allowlist URLAllowlist) *Service {
 	t.Helper()
@@ -0,0 +1,104 @@
+package neooracle
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"regexp"
+)
+
+// =============================================================================
+// Secret Templates
+// =============================================================================
+
+var errSecretStoreNotConfigured = errors.New("secret store not configured")
+
+// secretPlaceholder matches {{secret:NAME}} in the url, header values and body.
+var secretPlaceholder = regexp.MustCompile(`\{\{\s*secret:([A-Za-z0-9_.\-]+)\s*\}\}`)
+
+// secretResolver fetches each referenced secret once per request.
+type secretResolver struct {
+	ctx    context.Context
+	svc    *Service
+	userID string
+	values map[string]string
+}
+
+func (s *Service) newSecretResolver(ctx context.Context, userID string) *secretResolver {
+	return &secretResolver{ctx: ctx, svc: s, userID: userID, values: make(map[string]string)}
+}
+
+// hasSecretPlaceholders reports whether any of the inputs reference a secret.
+func hasSecretPlaceholders(input *QueryInput) bool {
+	if secretPlaceholder.MatchString(input.URL) || secretPlaceholder.MatchString(input.Body) {
+		return true
+	}
+	for _, v := range input.Headers {
+		if secretPlaceholder.MatchString(v) {
+			return true
+		}
+	}
+	return false
+}
+
+// get returns the named secret.
+func (r *secretResolver) get(name string) (string, error) {
+	if v, ok := r.values[name]; ok {
+		return v, nil
+	}
+	if r.svc.secretProvider == nil {
+		return "", errSecretStoreNotConfigured
+	}
+	v, err := r.svc.secretProvider.GetSecret(r.ctx, r.userID, name)
+	if err != nil {
+		return "", fmt.Errorf("fetch secret %q: %w", name, err)
+	}
+	r.values[name] = v
+	return v, nil
+}
+
+// expand replaces secret placeholders in tmpl. escape, when set, is applied to
+// each secret value (url.QueryEscape for URLs).
+func (r *secretResolver) expand(tmpl string, escape func(string) string) (string, error) {
+	var firstErr error
+	out := secretPlaceholder.ReplaceAllStringFunc(tmpl, func(match string) string {
+		if firstErr != nil {
+			return ""
+		}
+		name := secretPlaceholder.FindStringSubmatch(match)[1]
+		v, err := r.get(name)
+		if err != nil {
+			firstErr = err
+			return ""
+		}
+		if escape != nil {
+			v = escape(v)
+		}
+		return v
+	})
+	if firstErr != nil {
+		return "", firstErr
+	}
+	return out, nil
+}
+
+// expandInput interpolates secrets into the request URL, header values and
+// body.
+func (r *secretResolver) expandInput(input *QueryInput) (string, map[string]string, string, error) {
+	rawURL, err := r.expand(input.URL, url.QueryEscape)
+	if err != nil {
+		return "", nil, "", err
+	}
+	headers := make(map[string]string, len(input.Headers))
+	for k, v := range input.Headers {
+		if headers[k], err = r.expand(v, nil); err != nil {
+			return "", nil, "", err
+		}
+	}
+	body, err := r.expand(input.Body, nil)
+	if err != nil {
+		return "", nil, "", err
+	}
+	return rawURL, headers, body, nil
+}
@@ -9,6 +9,10 @@ type QueryInput struct {
 	SecretName  string            `json:"secret_name,omitempty"`   // optional: fetch secret and send as Authorization bearer
 	SecretAsKey string            `json:"secret_as_key,omitempty"` // optional: header key to place secret in (default Authorization: Bearer <secret>)
 	Body        string            `json:"body,omitempty"`          // optional body for POST/PUT
+	JSONPath    string            `json:"json_path,omitempty"`     // optional: extract a value from a JSON response (gjson syntax)
+	// CacheTTLSeconds lets identical requests within the window be served from
+	// cache (capped by the service's maximum). 0 disables caching.
+	CacheTTLSeconds int `json:"cache_ttl_seconds,omitempty"`
 }
 
 // QueryResponse returns the fetched data.
@@ -16,4 +20,7 @@ type QueryResponse struct {
 	StatusCode int               `json:"status_code"`
 	Headers    map[string]string `json:"headers"`
 	Body       string            `json:"body"`
+	JSONPath   string            `json:"json_path,omitempty"`
+	Value      any               `json:"value,omitempty"` // value at JSONPath, when requested
+	Cached     bool              `json:"cached,omitempty"`
 }
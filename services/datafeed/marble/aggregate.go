@@ -0,0 +1,54 @@
+package neofeeds
+
+import (
+	"errors"
+	"fmt"
+	"math"
+)
+
+// =============================================================================
+// Round Validation
+// =============================================================================
+
+var (
+	// ErrInsufficientSources rejects a round with fewer responding sources than
+	// the feed's min_sources.
+	ErrInsufficientSources = errors.New("too few price sources responded")
+	// ErrSpreadTooWide rejects a round whose sources disagree by more than the
+	// feed's max_spread_bps.
+	ErrSpreadTooWide = errors.New("price source spread exceeds limit")
+)
+
+// checkSourceCount enforces the feed's minimum number of responding sources.
+func checkSourceCount(feed *FeedConfig, values []SourcePrice) error {
+	minSources := 1
+	if feed != nil && feed.MinSources > minSources {
+		minSources = feed.MinSources
+	}
+	if len(values) < minSources {
+		return fmt.Errorf("%w: %d of %d required", ErrInsufficientSources, len(values), minSources)
+	}
+	return nil
+}
+
+// checkSourceSpread enforces the feed's maximum spread, measured as
+// (max - min) / median in basis points across the responding sources.
+func checkSourceSpread(feed *FeedConfig, values []SourcePrice, median float64) error {
+	if feed == nil || feed.MaxSpreadBps <= 0 || len(values) < 2 {
+		return nil
+	}
+	if median <= 0 {
+		return fmt.Errorf("%w: non-positive median %v", ErrSpreadTooWide, median)
+	}
+
+	lo, hi := math.Inf(1), math.Inf(-1)
+	for _, v := range values {
+		lo = math.Min(lo, v.Price)
+		hi = math.Max(hi, v.Price)
+	}
+	spreadBps := (hi - lo) / median * 10000
+	if spreadBps > float64(feed.MaxSpreadBps) {
+		return fmt.Errorf("%w: %.1f bps > %d bps", ErrSpreadTooWide, spreadBps, feed.MaxSpreadBps)
+	}
+	return nil
+}
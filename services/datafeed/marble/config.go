@@ -52,6 +52,18 @@ type FeedConfig struct {
 	Sources        []string      `json:"sources" yaml:"sources"`                                     // Source IDs to use
 	UpdateInterval time.Duration `json:"update_interval,omitempty" yaml:"update_interval,omitempty"` // Per-feed update interval
 	Enabled        bool          `json:"enabled" yaml:"enabled"`                                     // Whether feed is active
+
+	// MinSources rejects a round when fewer sources respond (default 1).
+	MinSources int `json:"min_sources,omitempty" yaml:"min_sources,omitempty"`
+	// MaxSpreadBps rejects a round when (max-min)/median across responding
+	// sources exceeds this many basis points. 0 disables the check.
+	MaxSpreadBps int `json:"max_spread_bps,omitempty" yaml:"max_spread_bps,omitempty"`
+	// DeviationPPM overrides the publish policy threshold for this feed, in
+	// parts per million (1000 = 0.1%).
+	DeviationPPM int `json:"deviation_ppm,omitempty" yaml:"deviation_ppm,omitempty"`
+	// HeartbeatInterval publishes the current price once this long has passed
+	// since the last publish, even without a deviation. 0 disables it.
+	HeartbeatInterval time.Duration `json:"heartbeat_interval,omitempty" yaml:"heartbeat_interval,omitempty"`
 }
 
 // PublishPolicyConfig controls when prices are anchored on-chain.
@@ -174,6 +186,21 @@ func (c *FeedsConfig) Validate() error {
 				return fmt.Errorf("feed[%d]: unknown source %q", i, srcID)
 			}
 		}
+		if feed.MinSources < 0 {
+			return fmt.Errorf("feed[%d]: min_sources must not be negative", i)
+		}
+		if feed.MinSources > len(feed.Sources) && len(feed.Sources) > 0 {
+			return fmt.Errorf("feed[%d]: min_sources %d exceeds %d configured sources", i, feed.MinSources, len(feed.Sources))
+		}
+		if feed.MaxSpreadBps < 0 {
+			return fmt.Errorf("feed[%d]: max_spread_bps must not be negative", i)
+		}
+		if feed.DeviationPPM < 0 {
+			return fmt.Errorf("feed[%d]: deviation_ppm must not be negative", i)
+		}
+		if feed.HeartbeatInterval < 0 {
+			return fmt.Errorf("feed[%d]: heartbeat_interval must not be negative", i)
+		}
 	}
 
 	if c.UpdateInterval <= 0 {
@@ -92,6 +92,30 @@ func TestConfigValidate(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "min_sources exceeds feed sources",
+			cfg: NeoFeedsConfig{
+				Sources: []SourceConfig{
+					{ID: "test", URL: "http://example.com", JSONPath: "price"},
+				},
+				Feeds: []FeedConfig{
+					{ID: "TEST/USD", Sources: []string{"test"}, MinSources: 2, Enabled: true},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "negative max_spread_bps",
+			cfg: NeoFeedsConfig{
+				Sources: []SourceConfig{
+					{ID: "test", URL: "http://example.com", JSONPath: "price"},
+				},
+				Feeds: []FeedConfig{
+					{ID: "TEST/USD", Sources: []string{"test"}, MaxSpreadBps: -1, Enabled: true},
+				},
+			},
+			wantErr: true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -165,29 +165,39 @@ func (s *Service) GetPrice(ctx context.Context, pair string) (*PriceResponse, er
 	// Preallocate slices with correct capacity
 	prices = make([]float64, 0, totalWeight)
 	sources = make([]string, 0, len(allResults))
+	sourceValues := make([]SourcePrice, 0, len(allResults))
 
-	// Process collected results
+	// Process collected results in a stable order
+	sort.Slice(allResults, func(i, j int) bool { return allResults[i].source < allResults[j].source })
 	for _, result := range allResults {
 		for i := 0; i < result.weight; i++ {
 			prices = append(prices, result.price)
 		}
 		sources = append(sources, result.source)
+		sourceValues = append(sourceValues, SourcePrice{Source: result.source, Price: result.price, Weight: result.weight})
 	}
 
 	if len(prices) == 0 {
 		return nil, fmt.Errorf("no prices available for %s", normalizedPair)
 	}
+	if err := checkSourceCount(feed, sourceValues); err != nil {
+		return nil, fmt.Errorf("%s: %w", feedID, err)
+	}
 
 	medianPrice := s.calculateMedian(prices)
+	if err := checkSourceSpread(feed, sourceValues, medianPrice); err != nil {
+		return nil, fmt.Errorf("%s: %w", feedID, err)
+	}
 	priceInt := int64(medianPrice * float64(pow10(decimals)))
 
 	response := &PriceResponse{
-		FeedID:    feedID,
-		Pair:      responsePair,
-		Price:     priceInt,
-		Decimals:  decimals,
-		Timestamp: time.Now(),
-		Sources:   sources,
+		FeedID:       feedID,
+		Pair:         responsePair,
+		Price:        priceInt,
+		Decimals:     decimals,
+		Timestamp:    time.Now(),
+		Sources:      sources,
+		SourceValues: sourceValues,
 	}
 
 	if len(s.signingKey) > 0 {
@@ -200,7 +210,7 @@ func (s *Service) GetPrice(ctx context.Context, pair string) (*PriceResponse, er
 	}
 
 	if s.DB() != nil {
-		if err := s.DB().CreatePriceFeed(ctx, &database.PriceFeed{
+		record := &database.PriceFeed{
 			ID:        uuid.New().String(),
 			FeedID:    feedID,
 			Pair:      responsePair,
@@ -209,7 +219,13 @@ func (s *Service) GetPrice(ctx context.Context, pair string) (*PriceResponse, er
 			Timestamp: response.Timestamp,
 			Sources:   response.Sources,
 			Signature: response.Signature,
-		}); err != nil {
+		}
+		// PostgREST rejects unknown columns, so only send source_values
+		// where the column exists.
+		if s.FeatureEnabled(FeaturePersistSourceValues) {
+			record.SourceValues = sourceValueMap(sourceValues)
+		}
+		if err := s.DB().CreatePriceFeed(ctx, record); err != nil {
 			s.Logger().WithContext(ctx).WithError(err).WithFields(map[string]interface{}{
 				"feed_id": feedID,
 				"pair":    pair,
@@ -220,6 +236,15 @@ func (s *Service) GetPrice(ctx context.Context, pair string) (*PriceResponse, er
 	return response, nil
 }
 
+// sourceValueMap flattens per-source prices for the stored snapshot.
+func sourceValueMap(values []SourcePrice) map[string]float64 {
+	out := make(map[string]float64, len(values))
+	for _, v := range values {
+		out[v.Source] = v.Price
+	}
+	return out
+}
+
 // findFeedByPair finds a feed config by pair or feed ID.
 func (s *Service) findFeedByPair(pair string) *FeedConfig {
 	query := normalizePair(pair)
@@ -296,7 +321,7 @@ func (s *Service) fetchPriceFromSource(ctx context.Context, pair string, feed *F
 			InitialDelay: 100 * time.Millisecond,
 			MaxDelay:     5 * time.Second,
 			Multiplier:   2.0,
-			Jitter:       0.1,
+			FullJitter:   true,
 		}, func() error {
 			resp, httpErr := s.httpClient.Do(req)
 			if httpErr != nil {
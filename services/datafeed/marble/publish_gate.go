@@ -0,0 +1,148 @@
+package neofeeds
+
+import (
+	"math/big"
+	"time"
+)
+
+// =============================================================================
+// Publish Gating
+// =============================================================================
+
+// Publish triggers.
+const (
+	publishTriggerDeviation = "deviation"
+	publishTriggerHeartbeat = "heartbeat"
+)
+
+// Publish skip reasons, recorded per symbol.
+const (
+	skipMinInterval          = "min_interval"
+	skipRateLimit            = "rate_limit"
+	skipBelowDeviation       = "below_deviation"
+	skipAwaitingConfirmation = "awaiting_confirmation"
+	skipHysteresis           = "hysteresis"
+)
+
+// publishThresholdsPPM returns the deviation that starts a publish and the
+// deviation a confirming observation must keep, in parts per million. A
+// feed's DeviationPPM overrides the global threshold; the confirmation
+// threshold keeps the global hysteresis/threshold ratio.
+func (s *Service) publishThresholdsPPM(feed *FeedConfig) (threshold, hysteresis int64) {
+	thresholdBps := int64(s.publishPolicy.ThresholdBps)
+	hysteresisBps := int64(s.publishPolicy.HysteresisBps)
+	if thresholdBps <= 0 {
+		thresholdBps = 10
+	}
+	if hysteresisBps <= 0 {
+		hysteresisBps = 8
+	}
+
+	threshold = thresholdBps * 100
+	hysteresis = hysteresisBps * 100
+	if feed != nil && feed.DeviationPPM > 0 {
+		threshold = int64(feed.DeviationPPM)
+		hysteresis = threshold * hysteresisBps / thresholdBps
+	}
+	return threshold, hysteresis
+}
+
+// publishGate decides whether newPrice should be published for state. It
+// returns the trigger when publishing, or the reason for skipping. Callers
+// hold publishMu.
+//
+// A feed publishes when its price has moved beyond the deviation threshold
+// on two consecutive observations, or unconditionally once its heartbeat
+// interval has elapsed since the last publish. The minimum interval and
+// per-minute cap apply to both.
+func (s *Service) publishGate(state *pricePublishState, feed *FeedConfig, newPrice int64, now time.Time) (trigger, skip string) {
+	minInterval := s.publishPolicy.MinInterval
+	if minInterval <= 0 {
+		minInterval = 5 * time.Second
+	}
+	maxPerMinute := s.publishPolicy.MaxPerMinute
+	if maxPerMinute <= 0 {
+		maxPerMinute = 30
+	}
+
+	// Enforce per-symbol minimum publish interval.
+	if !state.lastPublishedAt.IsZero() && now.Sub(state.lastPublishedAt) < minInterval {
+		return "", skipMinInterval
+	}
+
+	// Enforce per-symbol max frequency.
+	state.publishTimes = pruneRecentPublishes(state.publishTimes, now)
+	if len(state.publishTimes) >= maxPerMinute {
+		return "", skipRateLimit
+	}
+
+	if feed != nil && feed.HeartbeatInterval > 0 && !state.lastPublishedAt.IsZero() &&
+		now.Sub(state.lastPublishedAt) >= feed.HeartbeatInterval {
+		state.pending = nil
+		return publishTriggerHeartbeat, ""
+	}
+
+	threshold, hysteresis := s.publishThresholdsPPM(feed)
+	change := changePPM(state.lastPublishedPrice, newPrice)
+
+	// Two-step publish confirmation:
+	// - first observation must cross threshold (0.1% default)
+	// - second observation must stay beyond hysteresis (0.08% default)
+	if state.pending == nil {
+		if change < threshold {
+			return "", skipBelowDeviation
+		}
+		state.pending = &pendingPublish{startedAt: now}
+		return "", skipAwaitingConfirmation
+	}
+	if change < hysteresis {
+		state.pending = nil
+		return "", skipHysteresis
+	}
+
+	state.pending = nil
+	return publishTriggerDeviation, ""
+}
+
+// changePPM returns the relative change from oldPrice to newPrice in parts
+// per million.
+func changePPM(oldPrice, newPrice int64) int64 {
+	const forced = 1_000_000
+	if oldPrice <= 0 || newPrice <= 0 {
+		// Force publish when we don't have a baseline.
+		return forced
+	}
+	diff := newPrice - oldPrice
+	if diff < 0 {
+		diff = -diff
+	}
+	if diff == 0 {
+		return 0
+	}
+
+	n := big.NewInt(diff)
+	n.Mul(n, big.NewInt(1_000_000))
+	n.Div(n, big.NewInt(oldPrice))
+	if !n.IsInt64() {
+		return forced
+	}
+	return n.Int64()
+}
+
+// publishSkipSummary reports the last skip reason per symbol.
+func (s *Service) publishSkipSummary() map[string]any {
+	s.publishMu.Lock()
+	defer s.publishMu.Unlock()
+
+	out := make(map[string]any, len(s.publishState))
+	for symbol, state := range s.publishState {
+		if state == nil || state.lastSkipReason == "" {
+			continue
+		}
+		out[symbol] = map[string]any{
+			"reason": state.lastSkipReason,
+			"at":     state.lastSkipAt.UTC().Format(time.RFC3339),
+		}
+	}
+	return out
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"crypto/sha256"
 	"encoding/binary"
+	"errors"
 	"fmt"
 	"math/big"
 	"sort"
@@ -22,6 +23,10 @@ type pricePublishState struct {
 
 	pending      *pendingPublish
 	publishTimes []time.Time
+
+	// Why the most recent evaluation did not publish, for /info.
+	lastSkipReason string
+	lastSkipAt     time.Time
 }
 
 func (s *Service) hydratePriceFeedState(ctx context.Context) error {
@@ -113,6 +118,9 @@ func (s *Service) pushPricesToPriceFeed(ctx context.Context) {
 
 		price, err := s.GetPrice(ctx, symbol)
 		if err != nil {
+			if errors.Is(err, ErrInsufficientSources) || errors.Is(err, ErrSpreadTooWide) {
+				s.Logger().WithContext(ctx).WithError(err).WithField("symbol", symbol).Warn("price round rejected")
+			}
 			continue
 		}
 		if price == nil {
@@ -125,37 +133,17 @@ func (s *Service) pushPricesToPriceFeed(ctx context.Context) {
 		}
 
 		sourceSetID := sourceSetIDFromSources(price.Sources)
-		s.tryPublishPrice(ctx, symbol, price.Price, uint64(ts), sourceSetID)
+		s.tryPublishPrice(ctx, &feeds[i], symbol, price.Price, uint64(ts), sourceSetID)
 	}
 }
 
-func (s *Service) tryPublishPrice(ctx context.Context, symbol string, newPrice int64, timestamp uint64, sourceSetID *big.Int) {
+func (s *Service) tryPublishPrice(ctx context.Context, feed *FeedConfig, symbol string, newPrice int64, timestamp uint64, sourceSetID *big.Int) {
 	now := time.Now()
-	thresholdBps := int64(s.publishPolicy.ThresholdBps)
-	hysteresisBps := int64(s.publishPolicy.HysteresisBps)
-
-	if thresholdBps <= 0 {
-		thresholdBps = 10
-	}
-	if hysteresisBps <= 0 {
-		hysteresisBps = 8
-	}
-
-	minInterval := s.publishPolicy.MinInterval
-	if minInterval <= 0 {
-		minInterval = 5 * time.Second
-	}
-
-	maxPerMinute := s.publishPolicy.MaxPerMinute
-	if maxPerMinute <= 0 {
-		maxPerMinute = 30
-	}
 
 	var (
 		lastRoundID int64
 		lastPrice   int64
 		lastAt      time.Time
-		confirm     bool
 		nextRoundID int64
 	)
 
@@ -166,15 +154,10 @@ func (s *Service) tryPublishPrice(ctx context.Context, symbol string, newPrice i
 		s.publishState[symbol] = state
 	}
 
-	// Enforce per-symbol minimum publish interval.
-	if !state.lastPublishedAt.IsZero() && now.Sub(state.lastPublishedAt) < minInterval {
-		s.publishMu.Unlock()
-		return
-	}
-
-	// Enforce per-symbol max frequency.
-	state.publishTimes = pruneRecentPublishes(state.publishTimes, now)
-	if len(state.publishTimes) >= maxPerMinute {
+	trigger, skip := s.publishGate(state, feed, newPrice, now)
+	if skip != "" {
+		state.lastSkipReason = skip
+		state.lastSkipAt = now
 		s.publishMu.Unlock()
 		return
 	}
@@ -182,40 +165,17 @@ func (s *Service) tryPublishPrice(ctx context.Context, symbol string, newPrice i
 	lastRoundID = state.lastRoundID
 	lastPrice = state.lastPublishedPrice
 	lastAt = state.lastPublishedAt
-
-	change := changeBps(lastPrice, newPrice)
-
-	// Two-step publish confirmation:
-	// - first observation must cross threshold (0.1% default)
-	// - second observation must stay beyond hysteresis (0.08% default)
-	if state.pending == nil {
-		if change < thresholdBps {
-			s.publishMu.Unlock()
-			return
-		}
-		state.pending = &pendingPublish{startedAt: now}
-		s.publishMu.Unlock()
-		return
-	}
-
-	if change < hysteresisBps {
-		state.pending = nil
-		s.publishMu.Unlock()
-		return
-	}
-
-	// Confirm publish.
-	state.pending = nil
-	confirm = true
 	nextRoundID = lastRoundID + 1
 	if nextRoundID <= 0 {
 		nextRoundID = 1
 	}
 	s.publishMu.Unlock()
 
-	if !confirm {
-		return
-	}
+	s.Logger().WithContext(ctx).WithFields(map[string]interface{}{
+		"symbol":    symbol,
+		"trigger":   trigger,
+		"new_price": newPrice,
+	}).Debug("publishing price on-chain")
 
 	priceBig := big.NewInt(newPrice)
 	roundBig := big.NewInt(nextRoundID)
@@ -260,6 +220,7 @@ func (s *Service) tryPublishPrice(ctx context.Context, symbol string, newPrice i
 	state.lastPublishedPrice = newPrice
 	state.lastPublishedAt = now
 	state.publishTimes = append(state.publishTimes, now)
+	state.lastSkipReason = ""
 	s.publishMu.Unlock()
 }
 
@@ -305,32 +266,6 @@ func pruneRecentPublishes(times []time.Time, now time.Time) []time.Time {
 	return out
 }
 
-func changeBps(oldPrice, newPrice int64) int64 {
-	if oldPrice <= 0 || newPrice <= 0 {
-		// Force publish when we don't have a baseline.
-		return 10000
-	}
-	diff := newPrice - oldPrice
-	if diff < 0 {
-		diff = -diff
-	}
-	if diff == 0 {
-		return 0
-	}
-
-	n := big.NewInt(diff)
-	n.Mul(n, big.NewInt(10000))
-	d := big.NewInt(oldPrice)
-	if d.Sign() <= 0 {
-		return 10000
-	}
-	n.Div(n, d)
-	if !n.IsInt64() {
-		return 10000
-	}
-	return n.Int64()
-}
-
 func sourceSetIDFromSources(sources []string) *big.Int {
 	if len(sources) == 0 {
 		return big.NewInt(0)
@@ -36,6 +36,11 @@ const (
 
 	// Service fee per price update request (in GAS smallest unit)
 	ServiceFeePerUpdate = 10000 // 0.0001 GAS
+
+	// FeaturePersistSourceValues stores each source's price in the
+	// price_feeds.source_values column. Off by default because the column
+	// must be added first (see README).
+	FeaturePersistSourceValues = "persist_source_values"
 )
 
 // Service implements the NeoFeeds service.
@@ -186,7 +191,9 @@ func New(cfg Config) (*Service, error) {
 	s.attestationHash = marble.ComputeAttestationHash(cfg.Marble, ServiceID)
 
 	// Initialize circuit breaker for HTTP calls
-	s.httpCircuitBreaker = resilience.New(resilience.DefaultServiceCBConfig(s.Logger()))
+	cbConfig := resilience.DefaultServiceCBConfig(s.Logger())
+	cbConfig.Name = "http"
+	s.httpCircuitBreaker = resilience.New(cbConfig)
 
 	// Initialize rate limiter (defaults: 100 req/s, burst 200)
 	s.rateLimiter = middleware.NewRateLimiter(
@@ -271,6 +278,8 @@ func New(cfg Config) (*Service, error) {
 		}, commonservice.WithTickerWorkerName("chain-push"), commonservice.WithTickerWorkerImmediate())
 	}
 
+	base.WithFeature(FeaturePersistSourceValues, false)
+
 	// Register statistics provider for /info endpoint
 	base.WithStats(s.statistics)
 
@@ -300,6 +309,7 @@ func (s *Service) statistics() map[string]any {
 	if s.priceFeedAddress != "" {
 		stats["pricefeed_address"] = s.priceFeedAddress
 		stats["publish_policy"] = s.publishPolicySummary()
+		stats["publish_skips"] = s.publishSkipSummary()
 	}
 
 	return stats
@@ -4,6 +4,7 @@ package neofeeds
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -322,6 +323,107 @@ func TestGetPriceWithSigningKey(t *testing.T) {
 	t.Skip("Skipping - requires async test refactoring for proper mock server coordination")
 }
 
+func newMedianTestService(t *testing.T, prices map[string]string, minSources, maxSpreadBps int) *Service {
+	t.Helper()
+	cfg := &NeoFeedsConfig{
+		Feeds: []FeedConfig{{ID: "NEO-USD", MinSources: minSources, MaxSpreadBps: maxSpreadBps, Enabled: true}},
+	}
+	for id, price := range prices {
+		price := price
+		server := testutil.NewHTTPTestServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if price == "" {
+				w.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+			w.Write([]byte(`{"price":"` + price + `"}`))
+		}))
+		t.Cleanup(server.Close)
+		cfg.Sources = append(cfg.Sources, SourceConfig{ID: id, URL: server.URL, JSONPath: "price"})
+		cfg.Feeds[0].Sources = append(cfg.Feeds[0].Sources, id)
+	}
+	if err := cfg.Validate(); err != nil {
+		t.Fatalf("Validate() error = %v", err)
+	}
+
+	m, _ := marble.New(marble.Config{MarbleType: "neofeeds"})
+	svc, err := New(Config{Marble: m, FeedsConfig: cfg})
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	return svc
+}
+
+func TestGetPriceMedianWithSourceValues(t *testing.T) {
+	svc := newMedianTestService(t, map[string]string{"a": "10.0", "b": "10.2", "c": "10.1"}, 3, 500)
+
+	resp, err := svc.GetPrice(context.Background(), "NEO-USD")
+	if err != nil {
+		t.Fatalf("GetPrice() error = %v", err)
+	}
+	if resp.Price != 1010000000 {
+		t.Errorf("Price = %d, want median 1010000000", resp.Price)
+	}
+	if len(resp.SourceValues) != 3 || resp.SourceValues[0].Source != "a" || resp.SourceValues[0].Price != 10.0 {
+		t.Errorf("SourceValues = %+v", resp.SourceValues)
+	}
+}
+
+func TestGetPriceRejectsRound(t *testing.T) {
+	t.Run("too few sources", func(t *testing.T) {
+		svc := newMedianTestService(t, map[string]string{"a": "10.0", "b": "10.1", "c": ""}, 3, 0)
+		if _, err := svc.GetPrice(context.Background(), "NEO-USD"); !errors.Is(err, ErrInsufficientSources) {
+			t.Fatalf("err = %v, want ErrInsufficientSources", err)
+		}
+	})
+
+	t.Run("spread too wide", func(t *testing.T) {
+		svc := newMedianTestService(t, map[string]string{"a": "10.0", "b": "10.1", "c": "12.0"}, 0, 100)
+		if _, err := svc.GetPrice(context.Background(), "NEO-USD"); !errors.Is(err, ErrSpreadTooWide) {
+			t.Fatalf("err = %v, want ErrSpreadTooWide", err)
+		}
+	})
+}
+
+func TestPublishGateDeviationAndHeartbeat(t *testing.T) {
+	m, _ := marble.New(marble.Config{MarbleType: "neofeeds"})
+	svc, _ := New(Config{Marble: m})
+	feed := &FeedConfig{ID: "NEO-USD", DeviationPPM: 5000, HeartbeatInterval: time.Hour}
+	base := time.Now()
+	state := &pricePublishState{lastPublishedPrice: 1_000_000, lastPublishedAt: base}
+
+	// 0.4% move is below the 0.5% deviation.
+	if _, skip := svc.publishGate(state, feed, 1_004_000, base.Add(10*time.Second)); skip != skipBelowDeviation {
+		t.Fatalf("skip = %q, want %q", skip, skipBelowDeviation)
+	}
+	// 0.6% move needs a confirming observation.
+	if _, skip := svc.publishGate(state, feed, 1_006_000, base.Add(20*time.Second)); skip != skipAwaitingConfirmation {
+		t.Fatalf("skip = %q, want %q", skip, skipAwaitingConfirmation)
+	}
+	if trigger, skip := svc.publishGate(state, feed, 1_006_000, base.Add(30*time.Second)); trigger != publishTriggerDeviation || skip != "" {
+		t.Fatalf("trigger = %q, skip = %q, want deviation publish", trigger, skip)
+	}
+
+	// Once the heartbeat elapses an unchanged price is published.
+	state = &pricePublishState{lastPublishedPrice: 1_000_000, lastPublishedAt: base}
+	if trigger, _ := svc.publishGate(state, feed, 1_000_000, base.Add(time.Hour)); trigger != publishTriggerHeartbeat {
+		t.Fatalf("trigger = %q, want heartbeat", trigger)
+	}
+
+	// The minimum interval still applies.
+	if _, skip := svc.publishGate(state, feed, 2_000_000, base.Add(time.Second)); skip != skipMinInterval {
+		t.Fatalf("skip = %q, want %q", skip, skipMinInterval)
+	}
+}
+
+func TestChangePPM(t *testing.T) {
+	if got := changePPM(1_000_000, 1_001_000); got != 1000 {
+		t.Errorf("changePPM = %d, want 1000", got)
+	}
+	if got := changePPM(0, 5); got != 1_000_000 {
+		t.Errorf("changePPM without baseline = %d, want forced", got)
+	}
+}
+
 func TestFetchPriceSuccess(t *testing.T) {
 	mockServer := testutil.NewHTTPTestServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		w.Header().Set("Content-Type", "application/json")
@@ -24,8 +24,17 @@ type PriceResponse struct {
 	Decimals  int       `json:"decimals"`
 	Timestamp time.Time `json:"timestamp"`
 	Sources   []string  `json:"sources"`
-	Signature []byte    `json:"signature,omitempty"`
-	PublicKey []byte    `json:"public_key,omitempty"`
+	// SourceValues are the individual prices the median was taken over.
+	SourceValues []SourcePrice `json:"source_values,omitempty"`
+	Signature    []byte        `json:"signature,omitempty"`
+	PublicKey    []byte        `json:"public_key,omitempty"`
+}
+
+// SourcePrice is one source's contribution to an aggregated price.
+type SourcePrice struct {
+	Source string  `json:"source"`
+	Price  float64 `json:"price"`
+	Weight int     `json:"weight"`
 }
 
 // FeedSummary represents a feed entry returned by GET /feeds.
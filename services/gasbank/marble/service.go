@@ -88,7 +88,6 @@ type Service struct {
 	db          database.RepositoryInterface
 
 	depositAddress string
-
 }
 
 // getUserLock returns a per-user mutex for fine-grained locking.
@@ -166,6 +165,9 @@ func New(cfg Config) (*Service, error) {
 		}, commonservice.WithTickerWorkerName("auto-topup"))
 	}
 
+	// TOPUP_ENABLED sets the default; FEATURE_AUTO_TOPUP or /admin/features override it.
+	base.WithFeature(FeatureAutoTopUp, autoTopUpDefault())
+
 	// Register statistics provider for /info endpoint
 	base.WithStats(s.statistics)
 
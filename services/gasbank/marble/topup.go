@@ -26,6 +26,9 @@ const (
 
 	// TopUpBatchSize is the maximum number of accounts to process per run
 	TopUpBatchSize = 100
+
+	// FeatureAutoTopUp is the feature flag gating the auto top-up worker.
+	FeatureAutoTopUp = "auto_topup"
 )
 
 // processAutoTopUp checks pool accounts and tops up those with low GAS balance.
@@ -184,8 +187,15 @@ func (s *Service) getAccountPoolClient() (*neoaccountsclient.Client, error) {
 	})
 }
 
-// isAutoTopUpEnabled checks if auto top-up is enabled via environment variable.
-func (s *Service) isAutoTopUpEnabled() bool {
+// autoTopUpDefault reads the FeatureAutoTopUp default from TOPUP_ENABLED.
+func autoTopUpDefault() bool {
 	enabled := strings.TrimSpace(os.Getenv("TOPUP_ENABLED"))
 	return enabled == "true" || enabled == "1"
 }
+
+// isAutoTopUpEnabled reports whether the auto top-up feature is on. It is
+// resolved on every worker tick, so operators can pause top-ups at runtime
+// through /admin/features.
+func (s *Service) isAutoTopUpEnabled() bool {
+	return s.FeatureEnabled(FeatureAutoTopUp)
+}
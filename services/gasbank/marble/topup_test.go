@@ -28,7 +28,6 @@ func TestTopUpConstants(t *testing.T) {
 func TestIsAutoTopUpEnabled(t *testing.T) {
 	m, _ := marble.New(marble.Config{MarbleType: ServiceID})
 	mockDB := database.NewMockRepository()
-	svc, _ := New(Config{Marble: m, DB: mockDB})
 
 	tests := []struct {
 		name     string
@@ -48,6 +47,7 @@ func TestIsAutoTopUpEnabled(t *testing.T) {
 			os.Setenv("TOPUP_ENABLED", tt.envValue)
 			defer os.Unsetenv("TOPUP_ENABLED")
 
+			svc, _ := New(Config{Marble: m, DB: mockDB})
 			got := svc.isAutoTopUpEnabled()
 			if got != tt.want {
 				t.Errorf("isAutoTopUpEnabled() = %v, want %v", got, tt.want)
@@ -56,6 +56,21 @@ func TestIsAutoTopUpEnabled(t *testing.T) {
 	}
 }
 
+func TestAutoTopUpFeatureOverride(t *testing.T) {
+	t.Setenv("TOPUP_ENABLED", "true")
+	m, _ := marble.New(marble.Config{MarbleType: ServiceID})
+	svc, _ := New(Config{Marble: m, DB: database.NewMockRepository()})
+
+	svc.SetFeature(FeatureAutoTopUp, false)
+	if svc.isAutoTopUpEnabled() {
+		t.Fatal("an operator override should pause auto top-up")
+	}
+	svc.ClearFeature(FeatureAutoTopUp)
+	if !svc.isAutoTopUpEnabled() {
+		t.Fatal("clearing the override should restore the TOPUP_ENABLED default")
+	}
+}
+
 func TestProcessAutoTopUpNoChainClient(t *testing.T) {
 	m, _ := marble.New(marble.Config{MarbleType: ServiceID})
 	mockDB := database.NewMockRepository()
@@ -132,11 +147,11 @@ func TestTopUpAccountSimulated(t *testing.T) {
 func TestStatisticsIncludesTopUp(t *testing.T) {
 	m, _ := marble.New(marble.Config{MarbleType: ServiceID})
 	mockDB := database.NewMockRepository()
-	svc, _ := New(Config{Marble: m, DB: mockDB})
 
 	os.Setenv("TOPUP_ENABLED", "true")
 	defer os.Unsetenv("TOPUP_ENABLED")
 
+	svc, _ := New(Config{Marble: m, DB: mockDB})
 	stats := svc.statistics()
 
 	// Check that top-up related stats are present
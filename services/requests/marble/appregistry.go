@@ -83,15 +83,17 @@ func (s *Service) getAppRegistryInfo(ctx context.Context, appID string, chainID
 		}
 	}
 
-	info, err := chainCtx.AppRegistry.GetApp(ctx, appID)
-	if err != nil {
-		return nil, err
-	}
+	return loadShared(ctx, s, "registry:"+cacheKey, func(ctx context.Context) (*chain.AppRegistryApp, error) {
+		info, err := chainCtx.AppRegistry.GetApp(ctx, appID)
+		if err != nil {
+			return nil, err
+		}
 
-	if s.appRegistryTTL > 0 {
-		s.setAppRegistryCached(cacheKey, info)
-	}
-	return info, nil
+		if s.appRegistryTTL > 0 {
+			s.setAppRegistryCached(cacheKey, info)
+		}
+		return info, nil
+	})
 }
 
 func (s *Service) getAppRegistryCached(key string) (*chain.AppRegistryApp, bool) {
@@ -45,7 +45,7 @@ func (s *Service) postJSON(ctx context.Context, url, userID string, body any) ([
 			InitialDelay: 100 * time.Millisecond,
 			MaxDelay:     5 * time.Second,
 			Multiplier:   2.0,
-			Jitter:       0.1,
+			FullJitter:   true,
 		}, func() error {
 			resp, httpErr := s.httpClient.Do(req)
 			if httpErr != nil {
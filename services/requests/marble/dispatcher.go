@@ -567,17 +567,19 @@ func (s *Service) loadMiniApp(ctx context.Context, appID, chainID string) (*neor
 		return app, nil
 	}
 
-	app, err := s.repo.GetMiniApp(ctx, appID)
-	if err != nil {
-		if database.IsNotFound(err) {
-			s.cacheMiniAppNotFound(appID, "", "")
+	return loadShared(ctx, s, "app:"+chainID+":"+appID, func(ctx context.Context) (*neorequestsupabase.MiniApp, error) {
+		app, err := s.repo.GetMiniApp(ctx, appID)
+		if err != nil {
+			if database.IsNotFound(err) {
+				s.cacheMiniAppNotFound(appID, "", "")
+			}
+			return nil, err
 		}
-		return nil, err
-	}
 
-	contractAddress := appContractAddress(app, chainID)
-	s.cacheMiniApp(app, chainID, contractAddress)
-	return app, nil
+		contractAddress := appContractAddress(app, chainID)
+		s.cacheMiniApp(app, chainID, contractAddress)
+		return app, nil
+	})
 }
 
 func (s *Service) loadMiniAppByContractAddress(ctx context.Context, chainID, contractAddress string) (*neorequestsupabase.MiniApp, error) {
@@ -595,16 +597,18 @@ func (s *Service) loadMiniAppByContractAddress(ctx context.Context, chainID, con
 		return app, nil
 	}
 
-	app, err := s.repo.GetMiniAppByContractAddress(ctx, chainID, normalized)
-	if err != nil {
-		if database.IsNotFound(err) {
-			s.cacheMiniAppNotFound("", chainID, normalized)
+	return loadShared(ctx, s, miniAppCacheKey("contract:", chainID, normalized), func(ctx context.Context) (*neorequestsupabase.MiniApp, error) {
+		app, err := s.repo.GetMiniAppByContractAddress(ctx, chainID, normalized)
+		if err != nil {
+			if database.IsNotFound(err) {
+				s.cacheMiniAppNotFound("", chainID, normalized)
+			}
+			return nil, err
 		}
-		return nil, err
-	}
 
-	s.cacheMiniApp(app, chainID, normalized)
-	return app, nil
+		s.cacheMiniApp(app, chainID, normalized)
+		return app, nil
+	})
 }
 
 func (s *Service) markEventProcessed(ctx context.Context, event *chain.ContractEvent, parsed *chain.ServiceRequestedEvent) (bool, error) {
@@ -1,6 +1,7 @@
 package neorequests
 
 import (
+	"context"
 	"strings"
 	"time"
 
@@ -54,6 +55,34 @@ func (s *Service) deleteMiniAppCache(key string) {
 	s.miniAppCacheMu.Unlock()
 }
 
+// miniAppLoadTimeout bounds a shared cache-miss load. The load runs on behalf
+// of every waiter, so it is detached from the first caller's cancellation.
+const miniAppLoadTimeout = 15 * time.Second
+
+// loadShared runs load for a cache miss on key, sharing one in-flight call
+// among concurrent callers so a burst of events for the same app does not
+// stampede the backing store. Errors reach every waiter but are not cached;
+// load decides what, if anything, to cache. A caller whose ctx ends stops
+// waiting without failing the load for the others.
+func loadShared[T any](ctx context.Context, s *Service, key string, load func(context.Context) (T, error)) (T, error) {
+	ch := s.cacheLoads.DoChan(key, func() (interface{}, error) {
+		loadCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), miniAppLoadTimeout)
+		defer cancel()
+		return load(loadCtx)
+	})
+
+	var zero T
+	select {
+	case <-ctx.Done():
+		return zero, ctx.Err()
+	case res := <-ch:
+		if res.Err != nil {
+			return zero, res.Err
+		}
+		return res.Val.(T), nil
+	}
+}
+
 func miniAppCacheKey(prefix, chainID, value string) string {
 	value = strings.TrimSpace(value)
 	if value == "" {
@@ -0,0 +1,97 @@
+package neorequests
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestLoadSharedCollapsesConcurrentLoads(t *testing.T) {
+	s := &Service{}
+	release := make(chan struct{})
+	var calls atomic.Int32
+
+	load := func(ctx context.Context) (string, error) {
+		calls.Add(1)
+		<-release
+		return "app-1", nil
+	}
+
+	const callers = 8
+	var wg sync.WaitGroup
+	results := make(chan string, callers)
+	for i := 0; i < callers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			got, err := loadShared(context.Background(), s, "app:1", load)
+			if err != nil {
+				t.Errorf("loadShared() error = %v", err)
+				return
+			}
+			results <- got
+		}()
+	}
+
+	// Let every caller join the in-flight load before it completes.
+	time.Sleep(50 * time.Millisecond)
+	close(release)
+	wg.Wait()
+	close(results)
+
+	if n := calls.Load(); n != 1 {
+		t.Fatalf("load calls = %d, want 1", n)
+	}
+	for got := range results {
+		if got != "app-1" {
+			t.Fatalf("result = %q, want app-1", got)
+		}
+	}
+}
+
+func TestLoadSharedIgnoresFirstCallerCancellation(t *testing.T) {
+	s := &Service{}
+	started := make(chan struct{})
+	release := make(chan struct{})
+
+	load := func(ctx context.Context) (string, error) {
+		close(started)
+		select {
+		case <-release:
+			return "app-1", nil
+		case <-ctx.Done():
+			return "", ctx.Err()
+		}
+	}
+
+	firstCtx, cancel := context.WithCancel(context.Background())
+	firstErr := make(chan error, 1)
+	go func() {
+		_, err := loadShared(firstCtx, s, "app:1", load)
+		firstErr <- err
+	}()
+	<-started
+
+	second := make(chan string, 1)
+	go func() {
+		got, err := loadShared(context.Background(), s, "app:1", load)
+		if err != nil {
+			t.Errorf("second caller error = %v", err)
+		}
+		second <- got
+	}()
+	time.Sleep(20 * time.Millisecond)
+
+	cancel()
+	if err := <-firstErr; !errors.Is(err, context.Canceled) {
+		t.Fatalf("first caller err = %v, want context.Canceled", err)
+	}
+
+	close(release)
+	if got := <-second; got != "app-1" {
+		t.Fatalf("second caller result = %q, want app-1", got)
+	}
+}
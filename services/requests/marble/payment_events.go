@@ -3,7 +3,6 @@ package neorequests
 import (
 	"context"
 	"encoding/json"
-	"math/big"
 	"strings"
 
 	"github.com/R3E-Network/neo-miniapps-platform/infrastructure/chain"
@@ -91,15 +90,7 @@ func (s *Service) handlePaymentReceivedEvent(ctx context.Context, event *chain.C
 		return nil
 	}
 
-	var amount *big.Int
-	if parsed.Amount != "" {
-		amount = new(big.Int)
-		if _, ok := amount.SetString(parsed.Amount, 10); !ok {
-			amount = nil
-		}
-	}
-
-	if err := s.repo.BumpMiniAppUsage(ctx, user.ID, parsed.AppID, event.ChainID, amount, nil); err != nil {
+	if err := s.repo.BumpMiniAppUsage(ctx, user.ID, parsed.AppID, event.ChainID, parsed.Amount.BigInt(), nil); err != nil {
 		logger.WithContext(ctx).WithError(err).Warn("failed to bump miniapp usage")
 	}
 
@@ -3,6 +3,7 @@ package neorequests
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
 	"os"
@@ -11,6 +12,8 @@ import (
 	"sync"
 	"time"
 
+	"golang.org/x/sync/singleflight"
+
 	"github.com/R3E-Network/neo-miniapps-platform/infrastructure/chain"
 	"github.com/R3E-Network/neo-miniapps-platform/infrastructure/database"
 	"github.com/R3E-Network/neo-miniapps-platform/infrastructure/marble"
@@ -106,6 +109,9 @@ type Service struct {
 	miniAppCacheTTL         time.Duration
 	requireManifestContract bool
 
+	// cacheLoads collapses concurrent cache-miss lookups for the same key.
+	cacheLoads singleflight.Group
+
 	httpClient         *http.Client
 	httpCircuitBreaker *resilience.CircuitBreaker
 	vrfURL             string
@@ -186,24 +192,10 @@ func New(cfg Config) (*Service, error) {
 		// Create context for the default chain ID found
 		ctx := &ChainContext{ChainID: defaultID}
 
-		// Load addresses from env/secrets
-		if addr := os.Getenv("CONTRACT_SERVICE_GATEWAY_ADDRESS"); addr != "" {
-			ctx.ServiceGatewayAddress = normalizeContractAddress(addr)
-		} else if secret, ok := cfg.Marble.Secret("CONTRACT_SERVICE_GATEWAY_ADDRESS"); ok {
-			ctx.ServiceGatewayAddress = normalizeContractAddress(string(secret))
-		}
-
-		if addr := os.Getenv("CONTRACT_APP_REGISTRY_ADDRESS"); addr != "" {
-			ctx.AppRegistryAddress = normalizeContractAddress(addr)
-		} else if secret, ok := cfg.Marble.Secret("CONTRACT_APP_REGISTRY_ADDRESS"); ok {
-			ctx.AppRegistryAddress = normalizeContractAddress(string(secret))
-		}
-
-		if addr := os.Getenv("CONTRACT_PAYMENT_HUB_ADDRESS"); addr != "" {
-			ctx.PaymentHubAddress = normalizeContractAddress(addr)
-		} else if secret, ok := cfg.Marble.Secret("CONTRACT_PAYMENT_HUB_ADDRESS"); ok {
-			ctx.PaymentHubAddress = normalizeContractAddress(string(secret))
-		}
+		// Load addresses from env/secrets (env wins over the Marble secret)
+		ctx.ServiceGatewayAddress = normalizeContractAddress(envOrSecret(cfg.Marble, "CONTRACT_SERVICE_GATEWAY_ADDRESS"))
+		ctx.AppRegistryAddress = normalizeContractAddress(envOrSecret(cfg.Marble, "CONTRACT_APP_REGISTRY_ADDRESS"))
+		ctx.PaymentHubAddress = normalizeContractAddress(envOrSecret(cfg.Marble, "CONTRACT_PAYMENT_HUB_ADDRESS"))
 
 		// If we have chain infra config, try to resolve addresses from it
 		if chainInfraCfg != nil {
@@ -294,6 +286,9 @@ func New(cfg Config) (*Service, error) {
 		cacheSeconds = 60
 	}
 
+	cbConfig := resilience.DefaultServiceCBConfig(base.Logger())
+	cbConfig.Name = "http"
+
 	s := &Service{
 		BaseService:             base,
 		repo:                    repo,
@@ -306,7 +301,7 @@ func New(cfg Config) (*Service, error) {
 		miniAppCacheTTL:         time.Duration(cacheSeconds) * time.Second,
 		requireManifestContract: cfg.RequireManifestContract,
 		httpClient:              httpClient,
-		httpCircuitBreaker:      resilience.New(resilience.DefaultServiceCBConfig(base.Logger())),
+		httpCircuitBreaker:      resilience.New(cbConfig),
 		vrfURL:                  strings.TrimSpace(cfg.NeoVRFURL),
 		oracleURL:               strings.TrimSpace(cfg.NeoOracleURL),
 		computeURL:              strings.TrimSpace(cfg.NeoComputeURL),
@@ -346,14 +341,16 @@ func New(cfg Config) (*Service, error) {
 	}
 
 	base.RegisterStandardRoutes()
-	s.registerHandlers()
+	if err := s.registerHandlers(); err != nil {
+		return nil, err
+	}
 	s.registerStatsRollup()
 	s.registerRequestIndexCleanup()
 
 	return s, nil
 }
 
-func (s *Service) registerHandlers() {
+func (s *Service) registerHandlers() error {
 	for _, chainCtx := range s.chains {
 		if chainCtx.EventListener == nil || chainCtx.ServiceGatewayAddress == "" {
 			continue
@@ -361,43 +358,48 @@ func (s *Service) registerHandlers() {
 
 		l := chainCtx.EventListener
 
-		l.On("ServiceRequested", func(event *chain.ContractEvent) error {
-			return s.handleServiceRequested(context.Background(), event)
-		})
-		l.On("ServiceFulfilled", func(event *chain.ContractEvent) error {
-			return s.handleServiceFulfilled(context.Background(), event)
-		})
-		l.On("Platform_Notification", func(event *chain.ContractEvent) error {
-			return s.handleNotificationEvent(context.Background(), event)
-		})
-		l.On("Notification", func(event *chain.ContractEvent) error {
-			return s.handleNotificationEvent(context.Background(), event)
-		})
-		l.On("Platform_Metric", func(event *chain.ContractEvent) error {
-			return s.handleMetricEvent(context.Background(), event)
-		})
-		l.On("Metric", func(event *chain.ContractEvent) error {
-			return s.handleMetricEvent(context.Background(), event)
-		})
-		l.On("AppRegistered", func(event *chain.ContractEvent) error {
-			return s.handleAppRegistryEvent(context.Background(), event)
-		})
-		l.On("AppUpdated", func(event *chain.ContractEvent) error {
-			return s.handleAppRegistryEvent(context.Background(), event)
-		})
-		l.On("StatusChanged", func(event *chain.ContractEvent) error {
-			return s.handleAppRegistryEvent(context.Background(), event)
-		})
-		l.On("PaymentReceived", func(event *chain.ContractEvent) error {
-			return s.handlePaymentReceivedEvent(context.Background(), event)
-		})
-		l.OnAny(func(event *chain.ContractEvent) error {
-			return s.handleMiniAppContractEvent(context.Background(), event)
-		})
+		errs := []error{
+			l.On("ServiceRequested", func(event *chain.ContractEvent) error {
+				return s.handleServiceRequested(context.Background(), event)
+			}),
+			l.On("ServiceFulfilled", func(event *chain.ContractEvent) error {
+				return s.handleServiceFulfilled(context.Background(), event)
+			}),
+			l.On("Platform_Notification", func(event *chain.ContractEvent) error {
+				return s.handleNotificationEvent(context.Background(), event)
+			}),
+			l.On("Notification", func(event *chain.ContractEvent) error {
+				return s.handleNotificationEvent(context.Background(), event)
+			}),
+			l.On("Platform_Metric", func(event *chain.ContractEvent) error {
+				return s.handleMetricEvent(context.Background(), event)
+			}),
+			l.On("Metric", func(event *chain.ContractEvent) error {
+				return s.handleMetricEvent(context.Background(), event)
+			}),
+			l.On("AppRegistered", func(event *chain.ContractEvent) error {
+				return s.handleAppRegistryEvent(context.Background(), event)
+			}),
+			l.On("AppUpdated", func(event *chain.ContractEvent) error {
+				return s.handleAppRegistryEvent(context.Background(), event)
+			}),
+			l.On("StatusChanged", func(event *chain.ContractEvent) error {
+				return s.handleAppRegistryEvent(context.Background(), event)
+			}),
+			l.On("PaymentReceived", func(event *chain.ContractEvent) error {
+				return s.handlePaymentReceivedEvent(context.Background(), event)
+			}),
+			l.OnAny(func(event *chain.ContractEvent) error {
+				return s.handleMiniAppContractEvent(context.Background(), event)
+			}),
+		}
 		if s.onchainTxUsage {
-			l.OnTransaction(func(event *chain.TransactionEvent) error {
+			errs = append(errs, l.OnTransaction(func(event *chain.TransactionEvent) error {
 				return s.handleMiniAppTxEvent(context.Background(), event)
-			})
+			}))
+		}
+		if err := errors.Join(errs...); err != nil {
+			return fmt.Errorf("register %s event handlers: %w", chainCtx.ChainID, err)
 		}
 
 		listener := l // capture loop variable
@@ -405,6 +407,7 @@ func (s *Service) registerHandlers() {
 			s.runEventListener(ctx, listener)
 		})
 	}
+	return nil
 }
 
 func (s *Service) runEventListener(ctx context.Context, l *chain.EventListener) {
@@ -464,3 +467,13 @@ func (s *Service) getChainContext(chainID string) *ChainContext {
 func normalizeContractAddress(value string) string {
 	return chain.NormalizeContractAddress(value)
 }
+
+// envOrSecret returns the environment value for key if set, otherwise the
+// Marble secret of the same name. Contract addresses are commonly overridden
+// per deployment, so the environment takes precedence here.
+func envOrSecret(m *marble.Marble, key string) string {
+	if value := strings.TrimSpace(os.Getenv(key)); value != "" {
+		return value
+	}
+	return m.SecretOrEnv(key, "")
+}
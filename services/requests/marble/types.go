@@ -11,18 +11,20 @@ type rngResponse struct {
 	Randomness      string `json:"randomness"`
 	Signature       string `json:"signature,omitempty"`
 	PublicKey       string `json:"public_key,omitempty"`
+	Proof           string `json:"proof,omitempty"`
 	AttestationHash string `json:"attestation_hash,omitempty"`
 	Timestamp       int64  `json:"timestamp"`
 }
 
 type oraclePayload struct {
-	URL         string            `json:"url"`
-	Method      string            `json:"method,omitempty"`
-	Headers     map[string]string `json:"headers,omitempty"`
-	Body        string            `json:"body,omitempty"`
-	JSONPath    string            `json:"json_path,omitempty"`
-	SecretName  string            `json:"secret_name,omitempty"`
-	SecretAsKey string            `json:"secret_as_key,omitempty"`
+	URL             string            `json:"url"`
+	Method          string            `json:"method,omitempty"`
+	Headers         map[string]string `json:"headers,omitempty"`
+	Body            string            `json:"body,omitempty"`
+	JSONPath        string            `json:"json_path,omitempty"`
+	SecretName      string            `json:"secret_name,omitempty"`
+	SecretAsKey     string            `json:"secret_as_key,omitempty"`
+	CacheTTLSeconds int               `json:"cache_ttl_seconds,omitempty"`
 }
 
 type oracleResponse struct {
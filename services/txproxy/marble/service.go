@@ -2,7 +2,6 @@ package txproxy
 
 import (
 	"net/http"
-	"os"
 	"strings"
 	"time"
 
@@ -75,12 +74,7 @@ func New(cfg Config) (*Service, error) {
 	if allowlist == nil {
 		raw := strings.TrimSpace(cfg.AllowlistRaw)
 		if raw == "" {
-			if secret, ok := cfg.Marble.Secret("TXPROXY_ALLOWLIST"); ok && len(secret) > 0 {
-				raw = strings.TrimSpace(string(secret))
-			}
-		}
-		if raw == "" {
-			raw = strings.TrimSpace(os.Getenv("TXPROXY_ALLOWLIST"))
+			raw = cfg.Marble.SecretOrEnv("TXPROXY_ALLOWLIST", "TXPROXY_ALLOWLIST")
 		}
 
 		parsed, err := ParseAllowlist(raw)
@@ -120,6 +114,11 @@ func New(cfg Config) (*Service, error) {
 		rateLimiter: middleware.NewRateLimiterWithWindow(100, time.Minute, 200, base.Logger()),
 	}
 
+	// Record every submission attempt; permanent rejections are dead-lettered.
+	if cfg.ChainClient != nil {
+		cfg.ChainClient.OnSubmitAttempt(s.SubmitAttemptRecorder("", "", ""))
+	}
+
 	base.RegisterStandardRoutes()
 	s.registerRoutes()
 
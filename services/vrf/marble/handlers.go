@@ -1,24 +1,30 @@
 package neovrf
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"strings"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/gorilla/mux"
 
 	"github.com/R3E-Network/neo-miniapps-platform/infrastructure/crypto"
+	"github.com/R3E-Network/neo-miniapps-platform/infrastructure/database"
 	"github.com/R3E-Network/neo-miniapps-platform/infrastructure/httputil"
+	neovrfsupabase "github.com/R3E-Network/neo-miniapps-platform/services/vrf/supabase"
 )
 
 func (s *Service) registerRoutes() {
 	s.Router().HandleFunc("/random", s.handleRandom).Methods(http.MethodPost)
+	s.Router().HandleFunc("/random/requests/{id}/proof", s.handleGetProof).Methods(http.MethodGet)
 	s.Router().HandleFunc("/pubkey", s.handlePubKey).Methods(http.MethodGet)
 }
 
 func (s *Service) handleRandom(w http.ResponseWriter, r *http.Request) {
-	if _, ok := httputil.RequireUserID(w, r); !ok {
+	userID, ok := httputil.RequireUserID(w, r)
+	if !ok {
 		return
 	}
 
@@ -55,11 +61,22 @@ func (s *Service) handleRandom(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	randomness := crypto.Hash256(signature)
+	// Randomness is the ECVRF output for the request_id, so anyone holding the
+	// public key can check it with the returned proof.
+	proof, randomness, err := crypto.VRFProve(s.privateKey, []byte(requestID))
+	if err != nil {
+		s.Logger().WithContext(r.Context()).WithError(err).Error("failed to compute VRF proof")
+		httputil.InternalError(w, "failed to generate randomness")
+		return
+	}
 
 	resp := RandomResponse{
 		RequestID:  requestID,
 		Randomness: fmt.Sprintf("%x", randomness),
+		Proof:      fmt.Sprintf("%x", proof.Bytes()),
+		Gamma:      fmt.Sprintf("%x", proof.Gamma),
+		C:          fmt.Sprintf("%x", proof.C),
+		S:          fmt.Sprintf("%x", proof.S),
 		Timestamp:  time.Now().Unix(),
 	}
 	if len(signature) > 0 {
@@ -72,9 +89,71 @@ func (s *Service) handleRandom(w http.ResponseWriter, r *http.Request) {
 		resp.AttestationHash = fmt.Sprintf("%x", s.attestationHash)
 	}
 
+	s.storeProof(r.Context(), userID, &resp)
+
 	httputil.WriteJSON(w, http.StatusOK, resp)
 }
 
+// storeProof persists the proof for later retrieval. Failures are logged; the
+// caller still receives the proof inline.
+func (s *Service) storeProof(ctx context.Context, userID string, resp *RandomResponse) {
+	if s.repo == nil {
+		return
+	}
+	err := s.repo.CreateProof(ctx, &neovrfsupabase.Proof{
+		RequestID:       resp.RequestID,
+		UserID:          userID,
+		Randomness:      resp.Randomness,
+		Proof:           resp.Proof,
+		Gamma:           resp.Gamma,
+		C:               resp.C,
+		S:               resp.S,
+		PublicKey:       resp.PublicKey,
+		AttestationHash: resp.AttestationHash,
+		CreatedAt:       time.Unix(resp.Timestamp, 0).UTC(),
+	})
+	if err != nil {
+		s.Logger().WithContext(ctx).WithError(err).WithFields(map[string]interface{}{
+			"request_id": resp.RequestID,
+		}).Warn("failed to persist VRF proof")
+	}
+}
+
+func (s *Service) handleGetProof(w http.ResponseWriter, r *http.Request) {
+	userID, ok := httputil.RequireUserID(w, r)
+	if !ok {
+		return
+	}
+	if s.repo == nil {
+		httputil.ServiceUnavailable(w, "proof store not configured")
+		return
+	}
+
+	id := mux.Vars(r)["id"]
+	proof, err := s.repo.GetProof(r.Context(), id, userID)
+	if err != nil {
+		if database.IsNotFound(err) {
+			httputil.NotFound(w, "proof not found")
+			return
+		}
+		s.Logger().WithContext(r.Context()).WithError(err).Error("failed to load VRF proof")
+		httputil.InternalError(w, "failed to load proof")
+		return
+	}
+
+	httputil.WriteJSON(w, http.StatusOK, ProofResponse{
+		RequestID:       proof.RequestID,
+		Randomness:      proof.Randomness,
+		Proof:           proof.Proof,
+		Gamma:           proof.Gamma,
+		C:               proof.C,
+		S:               proof.S,
+		PublicKey:       proof.PublicKey,
+		AttestationHash: proof.AttestationHash,
+		CreatedAt:       proof.CreatedAt.Unix(),
+	})
+}
+
 func (s *Service) handlePubKey(w http.ResponseWriter, r *http.Request) {
 	if len(s.publicKey) == 0 {
 		httputil.ServiceUnavailable(w, "public key not available")
@@ -0,0 +1,124 @@
+package neovrf
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/R3E-Network/neo-miniapps-platform/infrastructure/database"
+	"github.com/R3E-Network/neo-miniapps-platform/infrastructure/marble"
+	neovrfsupabase "github.com/R3E-Network/neo-miniapps-platform/services/vrf/supabase"
+)
+
+type mockProofRepo struct {
+	mu     sync.Mutex
+	proofs map[string]neovrfsupabase.Proof
+}
+
+func newMockProofRepo() *mockProofRepo {
+	return &mockProofRepo{proofs: map[string]neovrfsupabase.Proof{}}
+}
+
+func (r *mockProofRepo) CreateProof(_ context.Context, proof *neovrfsupabase.Proof) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.proofs[proof.RequestID] = *proof
+	return nil
+}
+
+func (r *mockProofRepo) GetProof(_ context.Context, requestID, userID string) (*neovrfsupabase.Proof, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	proof, ok := r.proofs[requestID]
+	if !ok || proof.UserID != userID {
+		return nil, database.NewNotFoundError("neovrf_proofs", requestID)
+	}
+	return &proof, nil
+}
+
+func newTestService(t *testing.T, repo neovrfsupabase.RepositoryInterface) *Service {
+	t.Helper()
+	m, err := marble.New(marble.Config{MarbleType: ServiceID})
+	if err != nil {
+		t.Fatalf("marble.New() error = %v", err)
+	}
+	svc, err := New(Config{Marble: m, ProofRepo: repo})
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	return svc
+}
+
+func serve(svc *Service, method, path, userID string, body []byte) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, path, bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	if userID != "" {
+		req.Header.Set("X-User-ID", userID)
+	}
+	rec := httptest.NewRecorder()
+	svc.Router().ServeHTTP(rec, req)
+	return rec
+}
+
+func TestRandomProofRoundTrip(t *testing.T) {
+	svc := newTestService(t, newMockProofRepo())
+	requestID := "round-trip-request-0001"
+
+	body, _ := json.Marshal(RandomRequest{RequestID: requestID})
+	rec := serve(svc, http.MethodPost, "/random", "user-1", body)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("POST /random status = %d, body = %s", rec.Code, rec.Body.String())
+	}
+	var random RandomResponse
+	if err := json.Unmarshal(rec.Body.Bytes(), &random); err != nil {
+		t.Fatalf("decode random response: %v", err)
+	}
+
+	rec = serve(svc, http.MethodGet, "/random/requests/"+requestID+"/proof", "user-1", nil)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("GET proof status = %d, body = %s", rec.Code, rec.Body.String())
+	}
+	var stored ProofResponse
+	if err := json.Unmarshal(rec.Body.Bytes(), &stored); err != nil {
+		t.Fatalf("decode proof response: %v", err)
+	}
+	if stored.Proof != random.Proof || stored.Randomness != random.Randomness || stored.PublicKey != random.PublicKey {
+		t.Fatalf("stored proof %+v does not match issued %+v", stored, random)
+	}
+
+	randomness, err := Verify(stored.PublicKey, stored.RequestID, stored.Proof)
+	if err != nil {
+		t.Fatalf("Verify() error = %v", err)
+	}
+	if randomness != random.Randomness {
+		t.Fatalf("Verify() = %s, want %s", randomness, random.Randomness)
+	}
+}
+
+func TestGetProofOtherUserNotFound(t *testing.T) {
+	svc := newTestService(t, newMockProofRepo())
+	requestID := "other-user-request-0001"
+
+	body, _ := json.Marshal(RandomRequest{RequestID: requestID})
+	if rec := serve(svc, http.MethodPost, "/random", "user-1", body); rec.Code != http.StatusOK {
+		t.Fatalf("POST /random status = %d", rec.Code)
+	}
+
+	rec := serve(svc, http.MethodGet, "/random/requests/"+requestID+"/proof", "user-2", nil)
+	if rec.Code != http.StatusNotFound {
+		t.Fatalf("status = %d, want %d", rec.Code, http.StatusNotFound)
+	}
+}
+
+func TestGetProofWithoutRepo(t *testing.T) {
+	svc := newTestService(t, nil)
+
+	rec := serve(svc, http.MethodGet, "/random/requests/any-request-id-0001/proof", "user-1", nil)
+	if rec.Code != http.StatusServiceUnavailable {
+		t.Fatalf("status = %d, want %d", rec.Code, http.StatusServiceUnavailable)
+	}
+}
@@ -16,6 +16,7 @@ import (
 	"github.com/R3E-Network/neo-miniapps-platform/infrastructure/runtime"
 	"github.com/R3E-Network/neo-miniapps-platform/infrastructure/security"
 	commonservice "github.com/R3E-Network/neo-miniapps-platform/infrastructure/service"
+	neovrfsupabase "github.com/R3E-Network/neo-miniapps-platform/services/vrf/supabase"
 )
 
 const (
@@ -32,6 +33,7 @@ type Service struct {
 	publicKey        []byte
 	attestationHash  []byte
 	replayProtection *security.ReplayProtection
+	repo             neovrfsupabase.RepositoryInterface
 }
 
 // Config holds VRF service configuration.
@@ -39,6 +41,9 @@ type Config struct {
 	Marble       *marble.Marble
 	DB           database.RepositoryInterface
 	ReplayWindow time.Duration
+	// ProofRepo persists VRF proofs so they can be fetched after the fact.
+	// Optional; proofs are only returned inline when nil.
+	ProofRepo neovrfsupabase.RepositoryInterface
 }
 
 // New creates a new NeoVRF service.
@@ -64,6 +69,7 @@ func New(cfg Config) (*Service, error) {
 
 	s := &Service{
 		BaseService: base,
+		repo:        cfg.ProofRepo,
 	}
 	s.attestationHash = marble.ComputeAttestationHash(cfg.Marble, ServiceID)
 
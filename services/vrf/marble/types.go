@@ -9,10 +9,28 @@ type RandomResponse struct {
 	Randomness      string `json:"randomness"`
 	Signature       string `json:"signature,omitempty"`
 	PublicKey       string `json:"public_key,omitempty"`
+	Proof           string `json:"proof,omitempty"`
+	Gamma           string `json:"gamma,omitempty"`
+	C               string `json:"c,omitempty"`
+	S               string `json:"s,omitempty"`
 	AttestationHash string `json:"attestation_hash,omitempty"`
 	Timestamp       int64  `json:"timestamp"`
 }
 
+// ProofResponse is the stored VRF proof for a request. The request_id is the
+// VRF input (seed); randomness is the VRF output.
+type ProofResponse struct {
+	RequestID       string `json:"request_id"`
+	Randomness      string `json:"randomness"`
+	Proof           string `json:"proof"`
+	Gamma           string `json:"gamma"`
+	C               string `json:"c"`
+	S               string `json:"s"`
+	PublicKey       string `json:"public_key"`
+	AttestationHash string `json:"attestation_hash,omitempty"`
+	CreatedAt       int64  `json:"created_at"`
+}
+
 type PublicKeyResponse struct {
 	PublicKey       string `json:"public_key"`
 	AttestationHash string `json:"attestation_hash,omitempty"`
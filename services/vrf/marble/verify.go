@@ -0,0 +1,36 @@
+package neovrf
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/R3E-Network/neo-miniapps-platform/infrastructure/crypto"
+)
+
+// Verify checks a NeoVRF proof against the service public key and the seed
+// (the request_id) and returns the randomness it proves, hex encoded. All hex
+// arguments may carry a 0x prefix.
+//
+// Callers should compare the returned randomness with the value they were
+// given; a proof only vouches for the output it was computed for.
+func Verify(publicKeyHex, seed, proofHex string) (string, error) {
+	pubBytes, err := hex.DecodeString(strings.TrimPrefix(strings.TrimSpace(publicKeyHex), "0x"))
+	if err != nil {
+		return "", fmt.Errorf("decode public key: %w", err)
+	}
+	pub, err := crypto.PublicKeyFromBytes(pubBytes)
+	if err != nil {
+		return "", fmt.Errorf("parse public key: %w", err)
+	}
+	proof, err := hex.DecodeString(strings.TrimPrefix(strings.TrimSpace(proofHex), "0x"))
+	if err != nil {
+		return "", fmt.Errorf("decode proof: %w", err)
+	}
+
+	beta, err := crypto.VRFVerify(pub, []byte(seed), proof)
+	if err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(beta), nil
+}
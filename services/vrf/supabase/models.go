@@ -0,0 +1,19 @@
+// Package supabase provides NeoVRF-specific database operations.
+package supabase
+
+import "time"
+
+// Proof is a persisted VRF proof for a randomness request. Hex fields are
+// lowercase without a 0x prefix.
+type Proof struct {
+	RequestID       string    `json:"request_id"`
+	UserID          string    `json:"user_id"`
+	Randomness      string    `json:"randomness"`
+	Proof           string    `json:"proof"`
+	Gamma           string    `json:"gamma"`
+	C               string    `json:"c"`
+	S               string    `json:"s"`
+	PublicKey       string    `json:"public_key"`
+	AttestationHash string    `json:"attestation_hash,omitempty"`
+	CreatedAt       time.Time `json:"created_at"`
+}
@@ -0,0 +1,66 @@
+package supabase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/R3E-Network/neo-miniapps-platform/infrastructure/database"
+)
+
+const proofsTable = "neovrf_proofs"
+
+// RepositoryInterface defines NeoVRF data access methods.
+type RepositoryInterface interface {
+	CreateProof(ctx context.Context, proof *Proof) error
+	GetProof(ctx context.Context, requestID, userID string) (*Proof, error)
+}
+
+// Ensure Repository implements RepositoryInterface.
+var _ RepositoryInterface = (*Repository)(nil)
+
+// Repository provides NeoVRF-specific data access methods.
+type Repository struct {
+	base *database.Repository
+}
+
+// NewRepository creates a new NeoVRF repository.
+func NewRepository(base *database.Repository) *Repository {
+	return &Repository{base: base}
+}
+
+// CreateProof stores the proof for a randomness request.
+func (r *Repository) CreateProof(ctx context.Context, proof *Proof) error {
+	if proof == nil {
+		return fmt.Errorf("proof cannot be nil")
+	}
+	if proof.RequestID == "" {
+		return fmt.Errorf("request_id cannot be empty")
+	}
+	return database.GenericCreate(r.base, ctx, proofsTable, proof, func(rows []Proof) {
+		if len(rows) > 0 {
+			*proof = rows[0]
+		}
+	})
+}
+
+// GetProof returns the proof for a request scoped to a user.
+func (r *Repository) GetProof(ctx context.Context, requestID, userID string) (*Proof, error) {
+	if requestID == "" || userID == "" {
+		return nil, fmt.Errorf("request_id and user_id cannot be empty")
+	}
+
+	query := database.NewQuery().
+		Eq("request_id", requestID).
+		Eq("user_id", userID).
+		Limit(1).
+		Build()
+
+	rows, err := database.GenericListWithQuery[Proof](r.base, ctx, proofsTable, query)
+	if err != nil {
+		return nil, err
+	}
+	if len(rows) == 0 {
+		return nil, database.NewNotFoundError(proofsTable, requestID)
+	}
+	return &rows[0], nil
+}